@@ -0,0 +1,233 @@
+// Package asset implements a compact image format for e-paper assets and a
+// row-by-row decoder that feeds the epd47 streaming draws without
+// decompressing the whole image.
+//
+// An asset is a 16-byte header, an optional palette and the pixel rows:
+//
+//	0  "EPDI"
+//	4  version (1)
+//	5  stored depth in bits per pixel: 1, 2 or 4
+//	6  compression: 0 none, 1 PackBits per row
+//	7  palette length (0 for the default palette)
+//	8  width, uint16 little-endian
+//	10 height, uint16 little-endian
+//	12 pixel data length, uint32 little-endian
+//	16 palette: one ink level (0 white .. 15 black) per stored value
+//	   pixel data
+//
+// Stored rows are packed MSB first like the driver's formats, padded to a
+// whole byte. With PackBits every row is compressed on its own, so decoding
+// needs one row of scratch space. The default palette spreads the stored
+// values evenly from white (0) to black (15).
+//
+// This package has no dependency on the driver; Reader satisfies
+// epd47.RowSource.
+package asset
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// Compression identifies how pixel rows are stored.
+type Compression uint8
+
+const (
+	None     Compression = iota // packed rows as is
+	PackBits                    // each row PackBits-compressed
+)
+
+const (
+	magic      = "EPDI"
+	version    = 1
+	headerSize = 16
+)
+
+// Errors returned by Encode, Open and Reader.ReadRow.
+var (
+	ErrFormat  = errors.New("asset: not an EPDI asset or unsupported version")
+	ErrHeader  = errors.New("asset: invalid header")
+	ErrCorrupt = errors.New("asset: corrupt pixel data")
+	ErrRow     = errors.New("asset: row out of range or buffer too small")
+)
+
+// Image is an uncompressed image in stored form: Pix holds Height rows of
+// Stride() bytes at BPP bits per pixel, and Palette maps stored values to
+// ink levels (nil for the default palette).
+type Image struct {
+	Width, Height int
+	BPP           int
+	Palette       []uint8
+	Pix           []byte
+}
+
+// Stride returns the number of bytes per stored row.
+func (m *Image) Stride() int { return (m.Width*m.BPP + 7) / 8 }
+
+// FromPacked wraps a packed 1bpp or 4bpp driver image. A 4bpp image using
+// at most four ink levels is repacked at 1 or 2 bits per pixel with a
+// palette, which usually halves its size before compression.
+func FromPacked(bpp, width, height int, pix []byte) *Image {
+	m := &Image{Width: width, Height: height, BPP: bpp, Pix: pix}
+	if bpp != 4 {
+		return m
+	}
+
+	var used [16]bool
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			used[m.value(x, y)] = true
+		}
+	}
+	var pal []uint8
+	for l, u := range used {
+		if u {
+			pal = append(pal, uint8(l))
+		}
+	}
+	if len(pal) > 4 {
+		return m
+	}
+
+	r := &Image{Width: width, Height: height, BPP: 2, Palette: pal}
+	if len(pal) <= 2 {
+		r.BPP = 1
+	}
+	var index [16]uint8
+	for i, l := range pal {
+		index[l] = uint8(i)
+	}
+	r.Pix = make([]byte, r.Stride()*height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			r.set(x, y, index[m.value(x, y)])
+		}
+	}
+	return r
+}
+
+// value returns the stored value at x,y.
+func (m *Image) value(x, y int) uint8 {
+	return unpack(m.Pix[y*m.Stride():], x, m.BPP)
+}
+
+func (m *Image) set(x, y int, v uint8) {
+	bit := x * m.BPP
+	shift := 8 - m.BPP - bit&7
+	i := y*m.Stride() + bit>>3
+	mask := uint8(1<<m.BPP-1) << shift
+	m.Pix[i] = m.Pix[i]&^mask | v<<shift&mask
+}
+
+// unpack returns the bpp-bit value at index x of the packed row.
+func unpack(row []byte, x, bpp int) uint8 {
+	bit := x * bpp
+	shift := 8 - bpp - bit&7
+	return row[bit>>3] >> shift & (1<<bpp - 1)
+}
+
+// Encode serializes m, compressing rows with c.
+func Encode(m *Image, c Compression) ([]byte, error) {
+	if err := check(m, c); err != nil {
+		return nil, err
+	}
+	stride := m.Stride()
+	var data []byte
+	if c == PackBits {
+		for y := 0; y < m.Height; y++ {
+			data = appendPackBits(data, m.Pix[y*stride:(y+1)*stride])
+		}
+	} else {
+		data = m.Pix[:stride*m.Height]
+	}
+
+	b := appendHeader(make([]byte, 0, headerSize+len(m.Palette)+len(data)), m, c, len(data))
+	return append(b, data...), nil
+}
+
+// EncodeTo writes the encoding of m to w without holding the compressed
+// rows in memory; each row is compressed twice, once to size the header.
+func EncodeTo(w io.Writer, m *Image, c Compression) error {
+	n, err := dataSize(m, c)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(appendHeader(nil, m, c, n)); err != nil {
+		return err
+	}
+	stride := m.Stride()
+	if c != PackBits {
+		_, err := w.Write(m.Pix[:stride*m.Height])
+		return err
+	}
+	row := make([]byte, 0, maxPackBits(stride))
+	for y := 0; y < m.Height; y++ {
+		if _, err := w.Write(appendPackBits(row[:0], m.Pix[y*stride:(y+1)*stride])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EncodedSize returns the length of Encode(m, c) without building it.
+func EncodedSize(m *Image, c Compression) (int, error) {
+	n, err := dataSize(m, c)
+	return headerSize + len(m.Palette) + n, err
+}
+
+// MaxEncodedSize bounds the encoded length of any width x height image at
+// bpp bits per pixel, such as for checking a length read from storage.
+func MaxEncodedSize(width, height, bpp int) int {
+	stride := (width*bpp + 7) / 8
+	return headerSize + 1<<bpp + height*maxPackBits(stride)
+}
+
+// maxPackBits is the worst-case PackBits length of a row of n bytes: all
+// literal, one control byte per 128.
+func maxPackBits(n int) int { return n + (n+packBitsMax-1)/packBitsMax }
+
+// dataSize validates m and returns the length of its pixel data under c.
+func dataSize(m *Image, c Compression) (int, error) {
+	if err := check(m, c); err != nil {
+		return 0, err
+	}
+	stride := m.Stride()
+	if c != PackBits {
+		return stride * m.Height, nil
+	}
+	n := 0
+	row := make([]byte, 0, maxPackBits(stride))
+	for y := 0; y < m.Height; y++ {
+		n += len(appendPackBits(row[:0], m.Pix[y*stride:(y+1)*stride]))
+	}
+	return n, nil
+}
+
+// check validates m for encoding with c.
+func check(m *Image, c Compression) error {
+	if m.BPP != 1 && m.BPP != 2 && m.BPP != 4 {
+		return ErrHeader
+	}
+	if m.Width <= 0 || m.Height <= 0 || m.Width > 0xFFFF || m.Height > 0xFFFF {
+		return ErrHeader
+	}
+	if len(m.Palette) > 1<<m.BPP || c > PackBits {
+		return ErrHeader
+	}
+	if len(m.Pix) < m.Stride()*m.Height {
+		return ErrHeader
+	}
+	return nil
+}
+
+// appendHeader appends the header and palette of m with n bytes of pixel
+// data to b.
+func appendHeader(b []byte, m *Image, c Compression, n int) []byte {
+	b = append(b, magic...)
+	b = append(b, version, uint8(m.BPP), uint8(c), uint8(len(m.Palette)))
+	b = binary.LittleEndian.AppendUint16(b, uint16(m.Width))
+	b = binary.LittleEndian.AppendUint16(b, uint16(m.Height))
+	b = binary.LittleEndian.AppendUint32(b, uint32(n))
+	return append(b, m.Palette...)
+}
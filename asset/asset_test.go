@@ -0,0 +1,263 @@
+package asset
+
+import (
+	"bytes"
+	"testing"
+)
+
+// gradient4 returns a packed 4bpp image cycling through all 16 levels.
+func gradient4(w, h int) []byte {
+	stride := (w + 1) / 2
+	pix := make([]byte, stride*h)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			l := uint8((x + y) & 15)
+			pix[y*stride+x/2] |= l << (4 - 4*(x&1))
+		}
+	}
+	return pix
+}
+
+// readAll reads every row of r in order into one packed buffer.
+func readAll(t *testing.T, r *Reader, h int) []byte {
+	t.Helper()
+	out := make([]byte, r.Stride()*h)
+	for y := 0; y < h; y++ {
+		if err := r.ReadRow(y, out[y*r.Stride():(y+1)*r.Stride()]); err != nil {
+			t.Fatalf("ReadRow(%d) failed: %v", y, err)
+		}
+	}
+	return out
+}
+
+func TestPackBitsRoundTrip(t *testing.T) {
+	rows := [][]byte{
+		{1},
+		{7, 7},
+		{1, 2, 3, 4},
+		bytes.Repeat([]byte{0xAA}, 300),
+		append(append([]byte{1, 2, 3}, bytes.Repeat([]byte{9}, 5)...), 4, 5, 5, 6),
+	}
+	noise := make([]byte, 300)
+	for i := range noise {
+		noise[i] = uint8(i * 37)
+	}
+	rows = append(rows, noise)
+
+	for _, row := range rows {
+		enc := appendPackBits(nil, row)
+		dst := make([]byte, len(row))
+		pos, err := unpackBits(dst, string(enc), 0)
+		if err != nil || pos != len(enc) || !bytes.Equal(dst, row) {
+			t.Errorf("Round trip of % x failed: got % x at %d (%v)", row, dst, pos, err)
+		}
+	}
+	if n := len(appendPackBits(nil, bytes.Repeat([]byte{0}, 480))); n != 8 {
+		t.Errorf("Expected a blank 480-byte row to pack into 8 bytes, got %d", n)
+	}
+
+	if _, err := unpackBits(make([]byte, 4), "\x05\x01", 0); err != ErrCorrupt {
+		t.Errorf("Expected ErrCorrupt for a truncated literal, got %v", err)
+	}
+	if _, err := unpackBits(make([]byte, 2), "\xfd\x01", 0); err != ErrCorrupt {
+		t.Errorf("Expected ErrCorrupt for an overlong run, got %v", err)
+	}
+}
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	const w, h = 37, 9
+	pix := gradient4(w, h)
+	for _, c := range []Compression{None, PackBits} {
+		enc, err := Encode(FromPacked(4, w, h, pix), c)
+		if err != nil {
+			t.Fatalf("Encode failed: %v", err)
+		}
+		d, err := Open(string(enc))
+		if err != nil {
+			t.Fatalf("Open failed: %v", err)
+		}
+		if d.Width() != w || d.Height() != h || d.BPP() != 4 || d.Compression() != c {
+			t.Errorf("Unexpected header %dx%d %dbpp %d", d.Width(), d.Height(), d.BPP(), d.Compression())
+		}
+		if got := readAll(t, d.Rows4bpp(), h); !bytes.Equal(got, pix) {
+			t.Errorf("Compression %d: 4bpp rows differ", c)
+		}
+		m, err := d.Image()
+		if err != nil || !bytes.Equal(m.Pix, pix) {
+			t.Errorf("Compression %d: Image differs (%v)", c, err)
+		}
+	}
+}
+
+func TestEncodeTo(t *testing.T) {
+	m := &Image{Width: 300, Height: 7, BPP: 4, Pix: make([]byte, 150*7)}
+	for i := range m.Pix {
+		m.Pix[i] = byte(i * 37 >> 2) // mixed runs and literals
+	}
+	for _, c := range []Compression{None, PackBits} {
+		want, _ := Encode(m, c)
+		var buf bytes.Buffer
+		if err := EncodeTo(&buf, m, c); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(buf.Bytes(), want) {
+			t.Errorf("Compression %d: expected EncodeTo to match Encode", c)
+		}
+		if n, _ := EncodedSize(m, c); n != len(want) {
+			t.Errorf("Compression %d: expected size %d, got %d", c, len(want), n)
+		}
+	}
+
+	// Incompressible rows stay within the bound.
+	for i := range m.Pix {
+		m.Pix[i] = byte(i*97 + i>>3)
+	}
+	if n, _ := EncodedSize(m, PackBits); n > MaxEncodedSize(300, 7, 4) {
+		t.Errorf("Expected at most %d bytes, got %d", MaxEncodedSize(300, 7, 4), n)
+	}
+	if err := EncodeTo(&bytes.Buffer{}, &Image{Width: 1, Height: 1, BPP: 3}, None); err != ErrHeader {
+		t.Errorf("Expected ErrHeader, got %v", err)
+	}
+}
+
+func TestPaletteReduction(t *testing.T) {
+	// Two levels (white and 10) shrink to 1bpp with a palette.
+	const w, h = 20, 4
+	stride := (w + 1) / 2
+	pix := make([]byte, stride*h)
+	for i := range pix {
+		if i%3 == 0 {
+			pix[i] = 0xA0
+		}
+	}
+	m := FromPacked(4, w, h, pix)
+	if m.BPP != 1 || !bytes.Equal(m.Palette, []uint8{0, 10}) {
+		t.Fatalf("Expected 1bpp with palette [0 10], got %dbpp %v", m.BPP, m.Palette)
+	}
+	enc, _ := Encode(m, PackBits)
+	d, err := Open(string(enc))
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	if got := readAll(t, d.Rows4bpp(), h); !bytes.Equal(got, pix) {
+		t.Errorf("Expected palette expansion to restore the 4bpp rows:\n% x\n% x", got, pix)
+	}
+
+	// Level 10 is dark enough to ink at 1bpp: pixels 0 and 6 of row 0.
+	one := readAll(t, d.Rows1bpp(), h)
+	if one[0] != 0x82 {
+		t.Errorf("Expected pixels 0 and 6 inked at 1bpp, got % x", one[:3])
+	}
+
+	// Four levels use 2bpp; more stay at 4bpp.
+	four := []byte{0x05, 0xAF}
+	if m := FromPacked(4, 4, 1, four); m.BPP != 2 {
+		t.Errorf("Expected 2bpp for four levels, got %d", m.BPP)
+	}
+	if m := FromPacked(4, w, h, gradient4(w, h)); m.BPP != 4 || m.Palette != nil {
+		t.Errorf("Expected a 16-level image to stay 4bpp, got %d %v", m.BPP, m.Palette)
+	}
+}
+
+func TestReaderRestartsAndSkips(t *testing.T) {
+	const w, h = 16, 6
+	pix := gradient4(w, h)
+	enc, _ := Encode(FromPacked(4, w, h, pix), PackBits)
+	d, _ := Open(string(enc))
+	r := d.Rows4bpp()
+	row := make([]byte, r.Stride())
+	// Out of order: skip ahead, then go back to the top like a new frame.
+	for _, y := range []int{3, 5, 0, 1, 4} {
+		if err := r.ReadRow(y, row); err != nil {
+			t.Fatalf("ReadRow(%d) failed: %v", y, err)
+		}
+		if !bytes.Equal(row, pix[y*8:(y+1)*8]) {
+			t.Errorf("Row %d differs", y)
+		}
+	}
+	if err := r.ReadRow(h, row); err != ErrRow {
+		t.Errorf("Expected ErrRow past the end, got %v", err)
+	}
+	if err := r.ReadRow(0, row[:2]); err != ErrRow {
+		t.Errorf("Expected ErrRow for a short buffer, got %v", err)
+	}
+}
+
+func TestOpenErrors(t *testing.T) {
+	enc, _ := Encode(FromPacked(1, 8, 2, []byte{0xFF, 0x00}), None)
+	if _, err := Open(string(enc[:10])); err != ErrFormat {
+		t.Errorf("Expected ErrFormat for a short header, got %v", err)
+	}
+	if _, err := Open("XXXX" + string(enc[4:])); err != ErrFormat {
+		t.Errorf("Expected ErrFormat for a bad magic, got %v", err)
+	}
+	bad := append([]byte(nil), enc...)
+	bad[5] = 3
+	if _, err := Open(string(bad)); err != ErrHeader {
+		t.Errorf("Expected ErrHeader for 3bpp, got %v", err)
+	}
+	if _, err := Open(string(enc[:len(enc)-1])); err != ErrCorrupt {
+		t.Errorf("Expected ErrCorrupt for truncated data, got %v", err)
+	}
+	huge := append([]byte(nil), enc...)
+	copy(huge[12:16], "\xFF\xFF\xFF\xFF")
+	if _, err := Open(string(huge)); err != ErrCorrupt {
+		t.Errorf("Expected ErrCorrupt for a corrupt length, got %v", err)
+	}
+	if _, err := Encode(&Image{Width: 8, Height: 2, BPP: 1, Pix: []byte{1}}, None); err != ErrHeader {
+		t.Errorf("Expected ErrHeader for short pixels, got %v", err)
+	}
+}
+
+func TestSpriteSheet(t *testing.T) {
+	// A 3x2 sprite with a hole at 1,0 and an opaque 10x1 one.
+	sun := SpriteImage{Name: "sun", Width: 3, Height: 2, Pix: []byte{0xF1, 0x20, 0x34, 0x50}, Mask: []byte{0xA0, 0xE0}}
+	bar := SpriteImage{Name: "bar", Width: 10, Height: 1, Pix: gradient4(10, 1)}
+	enc, err := EncodeSheet([]SpriteImage{sun, bar})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := OpenSheet(string(enc))
+	if err != nil || s.Len() != 2 {
+		t.Fatalf("Expected 2 sprites, got %v", err)
+	}
+
+	sp := s.Lookup("sun")
+	if sp == nil || sp.ID != 0 || s.Sprite(0) != sp || sp.Width() != 3 || sp.Height() != 2 {
+		t.Fatalf("Unexpected sprite %+v", sp)
+	}
+	if sp.Level(0, 0) != 15 || sp.Level(1, 0) != 1 || sp.Level(2, 1) != 5 || sp.Level(3, 0) != 0 {
+		t.Error("Unexpected sprite levels")
+	}
+	if !sp.Opaque(0, 0) || sp.Opaque(1, 0) || sp.Alpha(1, 1) != 15 || sp.Alpha(-1, 0) != 0 {
+		t.Error("Unexpected sprite mask")
+	}
+	b := s.Sprite(1)
+	if b.Name != "bar" || b.Level(9, 0) != 9 || !b.Opaque(9, 0) {
+		t.Error("Expected an opaque bar with gradient levels")
+	}
+	if s.Sprite(2) != nil || s.Lookup("moon") != nil {
+		t.Error("Expected nil for missing sprites")
+	}
+
+	if _, err := OpenSheet(string(enc[:len(enc)-1])); err != ErrCorrupt {
+		t.Errorf("Expected ErrCorrupt for truncated pixels, got %v", err)
+	}
+	if _, err := OpenSheet(string(enc[:12])); err != ErrHeader {
+		t.Errorf("Expected ErrHeader for a truncated directory, got %v", err)
+	}
+	big := append([]byte(nil), enc...)
+	copy(big[8+1+3:], "\xFF\xFF\xFF\xFF\x00\x00\x00\x10")
+	if _, err := OpenSheet(string(big)); err != ErrCorrupt {
+		t.Errorf("Expected ErrCorrupt for a sprite past the data, got %v", err)
+	}
+	if _, err := OpenSheet("EPDS\x01\x00\xFF\xFF" + string(enc[8:])); err != ErrHeader {
+		t.Errorf("Expected ErrHeader for a sprite count past the directory, got %v", err)
+	}
+	if _, err := OpenSheet(string(enc[:4])); err != ErrFormat {
+		t.Errorf("Expected ErrFormat, got %v", err)
+	}
+	if _, err := EncodeSheet([]SpriteImage{{Name: "x", Width: 4, Height: 4, Pix: []byte{1}}}); err != ErrHeader {
+		t.Errorf("Expected ErrHeader for short pixels, got %v", err)
+	}
+}
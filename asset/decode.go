@@ -0,0 +1,174 @@
+package asset
+
+// Decoder holds a parsed asset. The pixel data is not copied: keep assets
+// in string constants so TinyGo leaves them in flash.
+type Decoder struct {
+	width, height int
+	bpp           int
+	compression   Compression
+	palette       [16]uint8
+	data          string
+}
+
+// Open parses the header of an encoded asset.
+func Open(data string) (*Decoder, error) {
+	if len(data) < headerSize || data[:4] != magic || data[4] != version {
+		return nil, ErrFormat
+	}
+	d := &Decoder{
+		bpp:         int(data[5]),
+		compression: Compression(data[6]),
+		width:       int(data[8]) | int(data[9])<<8,
+		height:      int(data[10]) | int(data[11])<<8,
+	}
+	npal := int(data[7])
+	// Kept unsigned: a size above 2 GiB must not go negative on 32-bit targets.
+	size := uint32(data[12]) | uint32(data[13])<<8 | uint32(data[14])<<16 | uint32(data[15])<<24
+	if d.bpp != 1 && d.bpp != 2 && d.bpp != 4 {
+		return nil, ErrHeader
+	}
+	if d.compression > PackBits || npal > 1<<d.bpp || d.width == 0 || d.height == 0 {
+		return nil, ErrHeader
+	}
+	if len(data) < headerSize+npal || uint64(size) > uint64(len(data)-headerSize-npal) {
+		return nil, ErrCorrupt
+	}
+
+	n := 1<<d.bpp - 1
+	for i := 0; i <= n; i++ {
+		d.palette[i] = uint8(i * 15 / n)
+	}
+	for i := 0; i < npal; i++ {
+		d.palette[i] = data[headerSize+i] & 0x0F
+	}
+	d.data = data[headerSize+npal : headerSize+npal+int(size)]
+	if d.compression == None && len(d.data) < d.stride()*d.height {
+		return nil, ErrCorrupt
+	}
+	return d, nil
+}
+
+// Width returns the image width in pixels.
+func (d *Decoder) Width() int { return d.width }
+
+// Height returns the image height in pixels.
+func (d *Decoder) Height() int { return d.height }
+
+// BPP returns the stored depth in bits per pixel.
+func (d *Decoder) BPP() int { return d.bpp }
+
+// Compression returns how the rows are stored.
+func (d *Decoder) Compression() Compression { return d.compression }
+
+// Palette returns the ink level for each stored value.
+func (d *Decoder) Palette() []uint8 { return d.palette[:1<<d.bpp] }
+
+func (d *Decoder) stride() int { return (d.width*d.bpp + 7) / 8 }
+
+// Rows4bpp returns a reader producing packed 4bpp rows for DrawRows4bpp.
+func (d *Decoder) Rows4bpp() *Reader { return d.reader(4) }
+
+// Rows1bpp returns a reader producing packed 1bpp rows for DrawRows1bpp;
+// levels 8 and darker are inked.
+func (d *Decoder) Rows1bpp() *Reader { return d.reader(1) }
+
+func (d *Decoder) reader(bpp int) *Reader {
+	r := &Reader{d: d, bpp: bpp}
+	if !r.isDirect() {
+		r.scratch = make([]byte, d.stride())
+	}
+	return r
+}
+
+// Image decodes the whole asset into stored form.
+func (d *Decoder) Image() (*Image, error) {
+	m := &Image{Width: d.width, Height: d.height, BPP: d.bpp, Palette: append([]uint8(nil), d.Palette()...)}
+	stride := m.Stride()
+	m.Pix = make([]byte, stride*m.Height)
+	pos := 0
+	for y := 0; y < d.height; y++ {
+		var err error
+		if pos, err = d.row(m.Pix[y*stride:(y+1)*stride], pos); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// row decodes the stored row at pos into dst and returns the next position.
+func (d *Decoder) row(dst []byte, pos int) (int, error) {
+	if d.compression == PackBits {
+		return unpackBits(dst, d.data, pos)
+	}
+	copy(dst, d.data[pos:])
+	return pos + len(dst), nil
+}
+
+// Reader streams decoded rows at an output depth of 1 or 4 bits per pixel.
+// Rows are cheapest read in order; asking for an earlier row restarts
+// decoding from the top, as happens on every 4bpp frame.
+type Reader struct {
+	d       *Decoder
+	bpp     int
+	next    int // row at pos
+	pos     int
+	scratch []byte // stored row, nil when stored rows are output rows
+}
+
+// Stride returns the number of bytes per output row.
+func (r *Reader) Stride() int { return (r.d.width*r.bpp + 7) / 8 }
+
+// isDirect reports whether stored rows already are output rows.
+func (r *Reader) isDirect() bool {
+	d := r.d
+	if d.bpp != r.bpp {
+		return false
+	}
+	n := 1<<d.bpp - 1
+	for i := 0; i <= n; i++ {
+		if d.palette[i] != uint8(i*15/n) {
+			return false
+		}
+	}
+	return true
+}
+
+// ReadRow fills dst with output row y. It implements epd47.RowSource.
+func (r *Reader) ReadRow(y int, dst []byte) error {
+	d := r.d
+	if y < 0 || y >= d.height || len(dst) < r.Stride() {
+		return ErrRow
+	}
+	if y < r.next {
+		r.next, r.pos = 0, 0
+	}
+	stored := r.scratch
+	if stored == nil {
+		stored = dst[:d.stride()]
+	}
+	for ; r.next <= y; r.next++ {
+		if d.compression == None && r.next < y {
+			r.pos += d.stride()
+			continue
+		}
+		var err error
+		if r.pos, err = d.row(stored, r.pos); err != nil {
+			r.next, r.pos = 0, 0
+			return err
+		}
+	}
+	if r.scratch == nil {
+		return nil
+	}
+
+	clear(dst[:r.Stride()])
+	for x := 0; x < d.width; x++ {
+		l := d.palette[unpack(stored, x, d.bpp)]
+		if r.bpp == 4 {
+			dst[x>>1] |= l << (4 - 4*(x&1))
+		} else if l >= 8 {
+			dst[x>>3] |= 0x80 >> (x & 7)
+		}
+	}
+	return nil
+}
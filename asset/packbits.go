@@ -0,0 +1,72 @@
+package asset
+
+// PackBits control bytes, as in TIFF and MacPaint:
+//
+//	0..127    the next n+1 bytes are literal
+//	129..255  the next byte repeats 257-n times
+//	128       no-op
+const packBitsMax = 128
+
+// appendPackBits appends the PackBits encoding of row to dst.
+func appendPackBits(dst, row []byte) []byte {
+	lit := 0 // start of the pending literal run
+	flush := func(end int) {
+		for lit < end {
+			n := min(end-lit, packBitsMax)
+			dst = append(dst, uint8(n-1))
+			dst = append(dst, row[lit:lit+n]...)
+			lit += n
+		}
+	}
+	for i := 0; i < len(row); {
+		run := 1
+		for i+run < len(row) && run < packBitsMax && row[i+run] == row[i] {
+			run++
+		}
+		// Runs of two only pay off when they do not split a literal.
+		if run >= 3 || (run == 2 && lit == i) {
+			flush(i)
+			dst = append(dst, uint8(257-run), row[i])
+			i += run
+			lit = i
+			continue
+		}
+		i += run
+	}
+	flush(len(row))
+	return dst
+}
+
+// unpackBits decodes one row from src at pos into dst and returns the
+// position after it.
+func unpackBits(dst []byte, src string, pos int) (int, error) {
+	for n := 0; n < len(dst); {
+		if pos >= len(src) {
+			return pos, ErrCorrupt
+		}
+		c := int(src[pos])
+		pos++
+		switch {
+		case c < 128:
+			c++
+			if n+c > len(dst) || pos+c > len(src) {
+				return pos, ErrCorrupt
+			}
+			copy(dst[n:], src[pos:pos+c])
+			pos += c
+			n += c
+		case c > 128:
+			c = 257 - c
+			if n+c > len(dst) || pos >= len(src) {
+				return pos, ErrCorrupt
+			}
+			v := src[pos]
+			pos++
+			for i := 0; i < c; i++ {
+				dst[n+i] = v
+			}
+			n += c
+		}
+	}
+	return pos, nil
+}
@@ -0,0 +1,183 @@
+package asset
+
+import "encoding/binary"
+
+// Sprite sheets bundle small images with a transparency mask, such as
+// icons reused across screens, addressed by id or name:
+//
+//	0  "EPDS"
+//	4  version (1)
+//	5  reserved (0)
+//	6  sprite count, uint16 little-endian
+//	8  directory, per sprite:
+//	     name length, name
+//	     width, height, uint16 little-endian
+//	     offset of the sprite's pixels after the directory, uint32 little-endian
+//	   pixels, per sprite: 4bpp level rows, then 1bpp mask rows (set bits opaque)
+//
+// Rows are packed MSB first and padded to a whole byte like the driver's
+// formats. Sprites are stored uncompressed so that scaled and rotated
+// blits can read any pixel straight from flash.
+const sheetMagic = "EPDS"
+
+// SpriteImage is a sprite to encode: packed 4bpp levels and an optional
+// packed 1bpp mask with set bits opaque. A nil Mask makes the sprite
+// opaque.
+type SpriteImage struct {
+	Name          string
+	Width, Height int
+	Pix           []byte
+	Mask          []byte
+}
+
+// EncodeSheet serializes sprites in order; a sprite's id is its index.
+func EncodeSheet(sprites []SpriteImage) ([]byte, error) {
+	if len(sprites) > 0xFFFF {
+		return nil, ErrHeader
+	}
+	b := append([]byte(sheetMagic), version, 0)
+	b = binary.LittleEndian.AppendUint16(b, uint16(len(sprites)))
+	var pix []byte
+	for _, s := range sprites {
+		if len(s.Name) > 255 || s.Width <= 0 || s.Height <= 0 || s.Width > 0xFFFF || s.Height > 0xFFFF {
+			return nil, ErrHeader
+		}
+		ps, ms := (s.Width+1)/2, (s.Width+7)/8
+		if len(s.Pix) < ps*s.Height || (s.Mask != nil && len(s.Mask) < ms*s.Height) {
+			return nil, ErrHeader
+		}
+		b = append(b, uint8(len(s.Name)))
+		b = append(b, s.Name...)
+		b = binary.LittleEndian.AppendUint16(b, uint16(s.Width))
+		b = binary.LittleEndian.AppendUint16(b, uint16(s.Height))
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(pix)))
+
+		pix = append(pix, s.Pix[:ps*s.Height]...)
+		if s.Mask != nil {
+			pix = append(pix, s.Mask[:ms*s.Height]...)
+			continue
+		}
+		for y := 0; y < s.Height; y++ {
+			for x := 0; x < s.Width; x += 8 {
+				pix = append(pix, 0xFF<<uint(max(0, x+8-s.Width)))
+			}
+		}
+	}
+	return append(b, pix...), nil
+}
+
+// Sheet is a parsed sprite sheet. Like Decoder it keeps pointing into the
+// encoded data.
+type Sheet struct {
+	sprites []Sprite
+}
+
+// OpenSheet parses an encoded sprite sheet.
+func OpenSheet(data string) (*Sheet, error) {
+	if len(data) < 8 || data[:4] != sheetMagic || data[4] != version {
+		return nil, ErrFormat
+	}
+	n := int(data[6]) | int(data[7])<<8
+	// A directory entry takes at least 9 bytes; check before allocating.
+	if n > (len(data)-8)/9 {
+		return nil, ErrHeader
+	}
+	s := &Sheet{sprites: make([]Sprite, n)}
+	offs := make([]uint32, n)
+	pos := 8
+	for i := range s.sprites {
+		if pos >= len(data) || pos+1+int(data[pos])+8 > len(data) {
+			return nil, ErrHeader
+		}
+		name := data[pos+1 : pos+1+int(data[pos])]
+		pos += 1 + len(name)
+		s.sprites[i] = Sprite{
+			ID:     i,
+			Name:   name,
+			width:  int(data[pos]) | int(data[pos+1])<<8,
+			height: int(data[pos+2]) | int(data[pos+3])<<8,
+		}
+		offs[i] = uint32(data[pos+4]) | uint32(data[pos+5])<<8 | uint32(data[pos+6])<<16 | uint32(data[pos+7])<<24
+		pos += 8
+	}
+	for i := range s.sprites {
+		sp := &s.sprites[i]
+		// In uint64 so that large sizes and offsets cannot wrap on 32-bit
+		// targets.
+		start := uint64(pos) + uint64(offs[i])
+		end := start + uint64(sp.pixStride()+sp.maskStride())*uint64(sp.height)
+		if sp.width == 0 || sp.height == 0 || end > uint64(len(data)) {
+			return nil, ErrCorrupt
+		}
+		sp.data = data[start:end]
+	}
+	return s, nil
+}
+
+// Len returns the number of sprites.
+func (s *Sheet) Len() int { return len(s.sprites) }
+
+// Sprite returns the sprite with the given id, or nil.
+func (s *Sheet) Sprite(id int) *Sprite {
+	if id < 0 || id >= len(s.sprites) {
+		return nil
+	}
+	return &s.sprites[id]
+}
+
+// Lookup returns the first sprite called name, or nil.
+func (s *Sheet) Lookup(name string) *Sprite {
+	for i := range s.sprites {
+		if s.sprites[i].Name == name {
+			return &s.sprites[i]
+		}
+	}
+	return nil
+}
+
+// Sprite is one image of a Sheet. It satisfies epd47.Sprite for blitting.
+type Sprite struct {
+	ID   int
+	Name string
+
+	width, height int
+	data          string // level rows, then mask rows
+}
+
+// Width returns the sprite width in pixels.
+func (s *Sprite) Width() int { return s.width }
+
+// Height returns the sprite height in pixels.
+func (s *Sprite) Height() int { return s.height }
+
+func (s *Sprite) pixStride() int  { return (s.width + 1) / 2 }
+func (s *Sprite) maskStride() int { return (s.width + 7) / 8 }
+
+func (s *Sprite) inside(x, y int) bool {
+	return x >= 0 && y >= 0 && x < s.width && y < s.height
+}
+
+// Level returns the ink level at x,y, 0 outside the sprite.
+func (s *Sprite) Level(x, y int) uint8 {
+	if !s.inside(x, y) {
+		return 0
+	}
+	return s.data[y*s.pixStride()+x>>1] >> (4 - 4*uint(x&1)) & 0x0F
+}
+
+// Opaque reports whether the mask covers x,y.
+func (s *Sprite) Opaque(x, y int) bool {
+	if !s.inside(x, y) {
+		return false
+	}
+	return s.data[s.pixStride()*s.height+y*s.maskStride()+x>>3]&(0x80>>uint(x&7)) != 0
+}
+
+// Alpha returns 15 where the sprite is opaque and 0 where it is
+// transparent, the coverage scale of epd47.AlphaMask.
+func (s *Sprite) Alpha(x, y int) uint8 {
+	if s.Opaque(x, y) {
+		return 15
+	}
+	return 0
+}
@@ -0,0 +1,145 @@
+// Package barcode encodes Code 128, EAN-13 and UPC-A linear barcodes for
+// the epd47 panel. Symbols render to a Bitmap1bpp at a whole number of
+// pixels per module, with their quiet zones and optional human-readable
+// text set in one of the font package's fonts, ready for Draw1bpp.
+//
+//	b, err := barcode.EAN13("400638133393")
+//	if err != nil {
+//		return err
+//	}
+//	bm := b.Bitmap(3, 120, font.DejaVuSans16) // 3px modules, 120px bars
+//	d.Draw1bpp(40, 40, bm.Width, bm.Height, bm.Pix, 10)
+//
+// A module is the narrowest bar or space. E-paper bars do not spread like
+// thermal print, so modules of 2 pixels or more scan reliably and no bar
+// width reduction is applied.
+package barcode
+
+import (
+	"errors"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+	"github.com/abaschen/tinygo-epd47-s3/font"
+)
+
+// Errors returned by the encoders.
+var (
+	ErrCharacter = errors.New("barcode: character cannot be encoded")
+	ErrLength    = errors.New("barcode: wrong number of digits")
+	ErrChecksum  = errors.New("barcode: check digit does not match")
+)
+
+// Kind is the symbology of a Barcode.
+type Kind uint8
+
+const (
+	KindCode128 Kind = iota
+	KindEAN13
+	KindUPCA
+)
+
+// Barcode is an encoded linear symbol.
+type Barcode struct {
+	Kind Kind
+	// Text is the human-readable interpretation: the data for Code 128,
+	// every digit including the check digit for EAN-13 and UPC-A.
+	Text string
+
+	bars []bool // dark modules, left to right, without quiet zones
+	long []bool // EAN and UPC guard bars that extend into the text; may be nil
+}
+
+// quiet returns the quiet zones in modules.
+func (b *Barcode) quiet() (left, right int) {
+	switch b.Kind {
+	case KindEAN13:
+		return 11, 7
+	case KindUPCA:
+		return 9, 9
+	}
+	return 10, 10
+}
+
+// Modules returns the width of the symbol in modules, quiet zones included.
+func (b *Barcode) Modules() int {
+	l, r := b.quiet()
+	return l + len(b.bars) + r
+}
+
+// Bitmap renders the symbol with module pixels per module and bars height
+// pixels tall. With a non-nil font the text is set under the bars, which
+// makes the bitmap taller by the font's height; EAN and UPC guard bars
+// reach halfway down into the text as printed labels do.
+func (b *Barcode) Bitmap(module, height int, f *font.Font) *epd47.Bitmap1bpp {
+	module = max(module, 1)
+	height = max(height, 1)
+	textH := 0
+	if f != nil {
+		textH = f.Ascent + f.Descent + 2
+	}
+	left, _ := b.quiet()
+	bm := epd47.NewBitmap1bpp(b.Modules()*module, height+textH)
+	for i, dark := range b.bars {
+		if !dark {
+			continue
+		}
+		h := height
+		if textH > 0 && b.long != nil && b.long[i] {
+			h += textH / 2
+		}
+		x0 := (left + i) * module
+		for y := 0; y < h; y++ {
+			for x := x0; x < x0+module; x++ {
+				bm.Set(x, y, true)
+			}
+		}
+	}
+	if f != nil {
+		b.drawText(bm, module, height+2+f.Ascent, f)
+	}
+	return bm
+}
+
+// drawText sets the human-readable text with its baseline at y.
+func (b *Barcode) drawText(bm *epd47.Bitmap1bpp, module, y int, f *font.Font) {
+	left, right := b.quiet()
+	n := len(b.bars)
+	// spread centers each digit of s in its share of modules a to z.
+	spread := func(s string, a, z int) {
+		cell := float32((z-a)*module) / float32(len(s))
+		for i := 0; i < len(s); i++ {
+			c := s[i : i+1]
+			x := float32((left+a)*module) + cell*(float32(i)+0.5) - float32(f.Width(c))/2
+			font.Draw(bm, f, int(x+0.5), y, c, 15)
+		}
+	}
+	switch b.Kind {
+	case KindEAN13:
+		// The first digit sits in the left quiet zone; the others under
+		// the two halves between the guards.
+		spread(b.Text[:1], -left, -1)
+		spread(b.Text[1:7], 3, 45)
+		spread(b.Text[7:], 50, 92)
+	case KindUPCA:
+		// The first and last digits sit outside; their bars extend like
+		// the guards.
+		spread(b.Text[:1], -left, -1)
+		spread(b.Text[1:6], 10, 45)
+		spread(b.Text[6:11], 50, 85)
+		spread(b.Text[11:], n+1, n+right)
+	default:
+		x := (bm.Width - f.Width(b.Text)) / 2
+		font.Draw(bm, f, x, y, b.Text, 15)
+	}
+}
+
+// appendWidths appends alternating bar and space widths, starting with a
+// bar, as modules.
+func appendWidths(bars []bool, widths string) []bool {
+	for i := 0; i < len(widths); i++ {
+		for w := widths[i] - '0'; w > 0; w-- {
+			bars = append(bars, i%2 == 0)
+		}
+	}
+	return bars
+}
@@ -0,0 +1,279 @@
+package barcode
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+	"github.com/abaschen/tinygo-epd47-s3/font"
+)
+
+func TestCode128Table(t *testing.T) {
+	seen := map[string]bool{}
+	for v, p := range code128Patterns {
+		sum, bars := 0, 0
+		for i := 0; i < len(p); i++ {
+			sum += int(p[i] - '0')
+			if i%2 == 0 {
+				bars += int(p[i] - '0')
+			}
+		}
+		want := 11
+		if v == c128Stop {
+			want = 13
+		}
+		if sum != want || bars%2 != 0 || seen[p] {
+			t.Errorf("Value %d: bad pattern %s", v, p)
+		}
+		seen[p] = true
+	}
+}
+
+func TestCode128Values(t *testing.T) {
+	cases := map[string]string{
+		"PJJ123C":      "104 48 42 42 17 18 19 35",
+		"123456":       "105 12 34 56",
+		"12345":        "105 12 34 100 21",
+		"12":           "105 12",
+		"AB1234567890": "104 33 34 99 12 34 56 78 90",
+		"X1234Y":       "104 56 17 18 19 20 57",
+		"X123456Y":     "104 56 99 12 34 56 100 57",
+		"X1234567Y":    "104 56 17 99 23 45 67 100 57",
+		"\tAb":         "103 73 33 100 66",
+		"a\tb":         "104 65 101 73 100 66",
+	}
+	for data, want := range cases {
+		v, err := code128Values(data)
+		if got := strings.Trim(fmt.Sprint(v), "[]"); err != nil || got != want {
+			t.Errorf("%q: expected %s, got %s (%v)", data, want, got, err)
+		}
+	}
+	if _, err := Code128("né"); err != ErrCharacter {
+		t.Errorf("Expected ErrCharacter, got %v", err)
+	}
+}
+
+// runs returns the widths of alternating dark and light runs along row y,
+// starting with the first dark pixel.
+func runs(bm *epd47.Bitmap1bpp, y int) []int {
+	var rs []int
+	x := 0
+	for x < bm.Width && !bm.Get(x, y) {
+		x++
+	}
+	for x < bm.Width {
+		c, n := bm.Get(x, y), 0
+		for x < bm.Width && bm.Get(x, y) == c {
+			x, n = x+1, n+1
+		}
+		if x == bm.Width && !c {
+			break // trailing quiet zone
+		}
+		rs = append(rs, n)
+	}
+	return rs
+}
+
+func TestCode128Scan(t *testing.T) {
+	for _, data := range []string{"PJJ123C", "SKU-000123456789", "Shelf 4\x1dB", ""} {
+		b, err := Code128(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bm := b.Bitmap(2, 40, nil)
+		if bm.Height != 40 || bm.Width != b.Modules()*2 {
+			t.Fatalf("%q: unexpected size %dx%d", data, bm.Width, bm.Height)
+		}
+		if bm.Get(19, 0) || !bm.Get(20, 0) || !bm.Get(20, 39) {
+			t.Errorf("%q: expected bars after a 10-module quiet zone", data)
+		}
+
+		rs := runs(bm, 20)
+		var values []int
+		for len(rs) >= 6 {
+			n := 6
+			if len(rs) == 7 {
+				n = 7
+			}
+			var p []byte
+			for _, w := range rs[:n] {
+				p = append(p, byte('0'+w/2))
+			}
+			v := -1
+			for i, q := range code128Patterns {
+				if q == string(p) {
+					v = i
+				}
+			}
+			values = append(values, v)
+			rs = rs[n:]
+		}
+		want, _ := code128Values(data)
+		if len(values) != len(want)+2 || values[len(values)-1] != c128Stop {
+			t.Fatalf("%q: scanned %v", data, values)
+		}
+		sum := values[0]
+		for i := 1; i < len(values)-2; i++ {
+			sum += i * values[i]
+		}
+		if fmt.Sprint(values[:len(want)]) != fmt.Sprint(want) || sum%103 != values[len(values)-2] {
+			t.Errorf("%q: scanned %v, expected %v and checksum %d", data, values, want, sum%103)
+		}
+	}
+}
+
+func TestCheckDigits(t *testing.T) {
+	if c := checkDigit("400638133393"); c != '1' {
+		t.Errorf("Expected EAN check digit 1, got %c", c)
+	}
+	if c := checkDigit("03600029145"); c != '2' {
+		t.Errorf("Expected UPC check digit 2, got %c", c)
+	}
+	if b, err := EAN13("590123412345"); err != nil || b.Text != "5901234123457" {
+		t.Errorf("Expected 5901234123457, got %v (%v)", b, err)
+	}
+	if _, err := EAN13("4006381333932"); err != ErrChecksum {
+		t.Errorf("Expected ErrChecksum, got %v", err)
+	}
+	if _, err := UPCA("0360002914"); err != ErrLength {
+		t.Errorf("Expected ErrLength, got %v", err)
+	}
+	if _, err := UPCA("03600029145X"); err != ErrCharacter {
+		t.Errorf("Expected ErrCharacter, got %v", err)
+	}
+}
+
+// scanEAN decodes the 95 modules of an EAN-13 or UPC-A drawn at one pixel
+// per module.
+func scanEAN(bm *epd47.Bitmap1bpp, left, y int) (string, error) {
+	bit := func(m int) byte {
+		if bm.Get(left+m, y) {
+			return '1'
+		}
+		return '0'
+	}
+	read := func(m, n int) string {
+		var p []byte
+		for i := 0; i < n; i++ {
+			p = append(p, bit(m+i))
+		}
+		return string(p)
+	}
+	if read(0, 3) != "101" || read(45, 5) != "01010" || read(92, 3) != "101" {
+		return "", fmt.Errorf("bad guards")
+	}
+	digits := make([]byte, 13)
+	parity := uint8(0)
+	for i := 0; i < 12; i++ {
+		m := 3 + 7*i
+		if i >= 6 {
+			m += 5
+		}
+		p := read(m, 7)
+		found := false
+		for d, l := range eanL {
+			switch {
+			case i < 6 && p == l:
+			case i < 6 && p == mirror(invert(l)):
+				parity |= 1 << uint(5-i)
+			case i >= 6 && p == invert(l):
+			default:
+				continue
+			}
+			digits[i+1], found = byte('0'+d), true
+		}
+		if !found {
+			return "", fmt.Errorf("digit %d: bad pattern %s", i+1, p)
+		}
+	}
+	for d, q := range eanParity {
+		if q == parity {
+			digits[0] = byte('0' + d)
+		}
+	}
+	return string(digits), nil
+}
+
+func TestEANScan(t *testing.T) {
+	b, _ := EAN13("4006381333931")
+	bm := b.Bitmap(1, 50, nil)
+	if bm.Width != 11+95+7 {
+		t.Errorf("Expected 113 modules, got %d", bm.Width)
+	}
+	if got, err := scanEAN(bm, 11, 10); err != nil || got != "4006381333931" {
+		t.Errorf("Expected 4006381333931, scanned %s (%v)", got, err)
+	}
+
+	u, _ := UPCA("03600029145")
+	if u.Text != "036000291452" || u.Modules() != 9+95+9 {
+		t.Errorf("Unexpected UPC-A %s of %d modules", u.Text, u.Modules())
+	}
+	if got, err := scanEAN(u.Bitmap(1, 50, nil), 9, 10); err != nil || got != "0036000291452" {
+		t.Errorf("Expected 0036000291452, scanned %s (%v)", got, err)
+	}
+}
+
+func TestText(t *testing.T) {
+	f := font.DejaVuSans16
+	textH := f.Ascent + f.Descent + 2
+	inked := func(bm *epd47.Bitmap1bpp, x0, y0, x1, y1 int) int {
+		n := 0
+		for y := y0; y < y1; y++ {
+			for x := x0; x < x1; x++ {
+				if bm.Get(x, y) {
+					n++
+				}
+			}
+		}
+		return n
+	}
+
+	b, _ := EAN13("4006381333931")
+	bm := b.Bitmap(2, 60, f)
+	if bm.Height != 60+textH {
+		t.Fatalf("Expected the text to add %d rows, got %d", textH, bm.Height-60)
+	}
+	// The start guard reaches into the text, a data bar does not.
+	if !bm.Get((11+0)*2, 60+textH/2-1) || bm.Get((11+4)*2, 61) {
+		t.Error("Expected only the guard bars to extend")
+	}
+	// Digits in the quiet zone and under both halves.
+	if inked(bm, 0, 62, 22, bm.Height) == 0 || inked(bm, 30, 62, 110, bm.Height) == 0 || inked(bm, 130, 62, 200, bm.Height) == 0 {
+		t.Error("Expected digits under the bars")
+	}
+	if inked(bm, 0, 0, 22, 60) != 0 {
+		t.Error("Expected the quiet zone to stay clear above the text")
+	}
+
+	u, _ := UPCA("036000291452")
+	bm = u.Bitmap(2, 60, f)
+	if inked(bm, (9+95)*2, 62, bm.Width, bm.Height) == 0 {
+		t.Error("Expected the UPC-A check digit right of the bars")
+	}
+	if !bm.Get((9+3+3)*2, 60+textH/2-1) { // 0 is 0001101
+		t.Error("Expected the first UPC-A digit's bars to extend")
+	}
+
+	c, _ := Code128("ABC-123")
+	bm = c.Bitmap(2, 40, f)
+	l, r := -1, -1
+	for x := 0; x < bm.Width; x++ {
+		if inked(bm, x, 42, x+1, bm.Height) > 0 {
+			if l < 0 {
+				l = x
+			}
+			r = x
+		}
+	}
+	if l < 0 || abs(l-(bm.Width-1-r)) > 3 {
+		t.Errorf("Expected centered text, got columns %d-%d of %d", l, r, bm.Width)
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,138 @@
+package barcode
+
+// code128Patterns holds the bar and space widths of the 103 symbol values,
+// the three start codes and the stop code.
+var code128Patterns = [107]string{
+	"212222", "222122", "222221", "121223", "121322", "131222", "122213", "122312", "132212", "221213",
+	"221312", "231212", "112232", "122132", "122231", "113222", "123122", "123221", "223211", "221132",
+	"221231", "213212", "223112", "312131", "311222", "321122", "321221", "312212", "322112", "322211",
+	"212123", "212321", "232121", "111323", "131123", "131321", "112313", "132113", "132311", "211313",
+	"231113", "231311", "112133", "112331", "132131", "113123", "113321", "133121", "313121", "211331",
+	"231131", "213113", "213311", "213131", "311123", "311321", "331121", "312113", "312311", "332111",
+	"314111", "221411", "431111", "111224", "111422", "121124", "121421", "141122", "141221", "112214",
+	"112412", "122114", "122411", "142112", "142211", "241211", "221114", "413111", "241112", "134111",
+	"111242", "121142", "121241", "114212", "124112", "124211", "411212", "421112", "421211", "212141",
+	"214121", "412121", "111143", "111341", "131141", "114113", "114311", "411113", "411311", "113141",
+	"114131", "311141", "411131", "211412", "211214", "211232", "2331112",
+}
+
+// Code 128 special values.
+const (
+	c128CodeC  = 99
+	c128CodeB  = 100
+	c128CodeA  = 101
+	c128StartA = 103
+	c128StartB = 104
+	c128StartC = 105
+	c128Stop   = 106
+)
+
+// Code128 encodes ASCII data as Code 128, switching between code sets A,
+// B and C to keep the symbol short: runs of digits are packed two per
+// symbol and control characters use set A.
+func Code128(data string) (*Barcode, error) {
+	values, err := code128Values(data)
+	if err != nil {
+		return nil, err
+	}
+	sum := values[0]
+	for i := 1; i < len(values); i++ {
+		sum += i * values[i]
+	}
+	values = append(values, sum%103, c128Stop)
+
+	var bars []bool
+	for _, v := range values {
+		bars = appendWidths(bars, code128Patterns[v])
+	}
+	return &Barcode{Kind: KindCode128, Text: data, bars: bars}, nil
+}
+
+// code128Values returns the start code and data values of s.
+func code128Values(s string) ([]int, error) {
+	for i := 0; i < len(s); i++ {
+		if s[i] > 127 {
+			return nil, ErrCharacter
+		}
+	}
+	var values []int
+	set := byte(0) // 'A', 'B' or 'C'
+	for i := 0; i < len(s); {
+		if n := digitRun(s, i); set != 'C' && useC(n, i == 0, i+n == len(s)) {
+			if n%2 == 1 && set != 0 {
+				// Odd run: the first digit stays in the current set.
+				values = append(values, code128Value(set, s[i]))
+				i++
+			} else if n%2 == 1 {
+				// At the start the last digit is left for the next set.
+				n--
+			}
+			if set == 0 {
+				values = append(values, c128StartC)
+			} else {
+				values = append(values, c128CodeC)
+			}
+			set = 'C'
+			for n -= n % 2; n > 0; n -= 2 {
+				values = append(values, int(s[i]-'0')*10+int(s[i+1]-'0'))
+				i += 2
+			}
+			continue
+		}
+
+		c := s[i]
+		want := byte('B')
+		if c < 32 || (set == 'A' && c < 96) {
+			want = 'A'
+		}
+		if set != want {
+			switch {
+			case set == 0 && want == 'A':
+				values = append(values, c128StartA)
+			case set == 0:
+				values = append(values, c128StartB)
+			case want == 'A':
+				values = append(values, c128CodeA)
+			default:
+				values = append(values, c128CodeB)
+			}
+			set = want
+		}
+		values = append(values, code128Value(set, c))
+		i++
+	}
+	if set == 0 {
+		values = append(values, c128StartB)
+	}
+	return values, nil
+}
+
+// useC reports whether a run of n digits is worth switching to set C: four
+// at either end of the data, six in the middle, or two if they are all of
+// it.
+func useC(n int, start, end bool) bool {
+	switch {
+	case start && end:
+		return n >= 2 && n%2 == 0 || n >= 4
+	case start || end:
+		return n >= 4
+	}
+	return n >= 6
+}
+
+// digitRun returns the number of consecutive digits at s[i:].
+func digitRun(s string, i int) int {
+	n := 0
+	for i+n < len(s) && s[i+n] >= '0' && s[i+n] <= '9' {
+		n++
+	}
+	return n
+}
+
+// code128Value returns the value of c in set A or B.
+func code128Value(set, c byte) int {
+	if set == 'A' && c < 32 {
+		return int(c) + 64
+	}
+	return int(c) - 32
+}
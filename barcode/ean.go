@@ -0,0 +1,122 @@
+package barcode
+
+// eanL holds the left-hand odd parity (set A) digit patterns; set C is
+// their complement and set B the mirrored complement.
+var eanL = [10]string{
+	"0001101", "0011001", "0010011", "0111101", "0100011",
+	"0110001", "0101111", "0111011", "0110111", "0001011",
+}
+
+// eanParity selects set A (0) or B (1) for the six left digits, keyed by
+// the leading digit of an EAN-13.
+var eanParity = [10]uint8{
+	0b000000, 0b001011, 0b001101, 0b001110, 0b010011,
+	0b011001, 0b011100, 0b010101, 0b010110, 0b011010,
+}
+
+// EAN13 encodes a 13-digit EAN. Given 12 digits the check digit is
+// appended; given 13 it is verified.
+func EAN13(digits string) (*Barcode, error) {
+	s, err := withCheckDigit(digits, 12)
+	if err != nil {
+		return nil, err
+	}
+	return ean(s, KindEAN13), nil
+}
+
+// UPCA encodes a 12-digit UPC-A. Given 11 digits the check digit is
+// appended; given 12 it is verified.
+func UPCA(digits string) (*Barcode, error) {
+	s, err := withCheckDigit(digits, 11)
+	if err != nil {
+		return nil, err
+	}
+	// A UPC-A is an EAN-13 with a leading zero.
+	b := ean("0"+s, KindUPCA)
+	b.Text = s
+	return b, nil
+}
+
+// withCheckDigit validates n digits plus an optional check digit and
+// returns them with the check digit.
+func withCheckDigit(s string, n int) (string, error) {
+	if len(s) != n && len(s) != n+1 {
+		return "", ErrLength
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return "", ErrCharacter
+		}
+	}
+	c := checkDigit(s[:n])
+	if len(s) == n {
+		return s + string(c), nil
+	}
+	if s[n] != c {
+		return "", ErrChecksum
+	}
+	return s, nil
+}
+
+// checkDigit returns the modulo 10 check digit of s, weighting digits 3
+// and 1 alternately from the right.
+func checkDigit(s string) byte {
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		d := int(s[len(s)-1-i] - '0')
+		if i%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// ean builds the 95 modules of the 13 digits in s.
+func ean(s string, kind Kind) *Barcode {
+	b := &Barcode{Kind: kind, Text: s}
+	guard := func(p string) {
+		for i := 0; i < len(p); i++ {
+			b.bars = append(b.bars, p[i] == '1')
+			b.long = append(b.long, true)
+		}
+	}
+	digit := func(p string, long bool) {
+		for i := 0; i < len(p); i++ {
+			b.bars = append(b.bars, p[i] == '1')
+			b.long = append(b.long, long)
+		}
+	}
+
+	parity := eanParity[s[0]-'0']
+	guard("101")
+	for i := 1; i <= 6; i++ {
+		p := eanL[s[i]-'0']
+		if parity>>(6-uint(i))&1 != 0 {
+			p = mirror(invert(p))
+		}
+		digit(p, kind == KindUPCA && i == 1)
+	}
+	guard("01010")
+	for i := 7; i <= 12; i++ {
+		digit(invert(eanL[s[i]-'0']), kind == KindUPCA && i == 12)
+	}
+	guard("101")
+	return b
+}
+
+func invert(p string) string {
+	b := []byte(p)
+	for i := range b {
+		b[i] ^= '0' ^ '1'
+	}
+	return string(b)
+}
+
+func mirror(p string) string {
+	b := []byte(p)
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return string(b)
+}
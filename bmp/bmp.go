@@ -0,0 +1,224 @@
+// Package bmp decodes uncompressed 1, 4 and 8-bit indexed BMP files row by
+// row into the epd47 packed formats.
+//
+// Rows are read with io.ReaderAt, so bottom-up and top-down files both
+// stream top row first and rows can be re-read on every 4bpp frame without
+// keeping the image in memory; only one file row of scratch space is
+// allocated per Reader. Palette entries are mapped to ink levels by their
+// luminance once when the file is opened. bytes.Reader, strings.Reader and
+// os.File all implement io.ReaderAt.
+package bmp
+
+import (
+	"encoding/binary"
+	"errors"
+	"image/color"
+	"io"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+)
+
+// Errors returned by Open and Reader.ReadRow.
+var (
+	ErrFormat      = errors.New("bmp: not a BMP file")
+	ErrUnsupported = errors.New("bmp: only uncompressed 1, 4 and 8-bit indexed BMPs are supported")
+	ErrHeader      = errors.New("bmp: invalid header")
+	ErrData        = errors.New("bmp: truncated pixel data")
+	ErrRow         = errors.New("bmp: row out of range or buffer too small")
+)
+
+// maxSize bounds width and height to reject corrupt headers early.
+const maxSize = 1 << 14
+
+const (
+	fileHeaderSize = 14
+	coreHeaderSize = 12 // OS/2 BITMAPCOREHEADER
+	infoHeaderSize = 40 // BITMAPINFOHEADER and later versions
+)
+
+// Decoder holds a parsed BMP header and palette.
+type Decoder struct {
+	r             io.ReaderAt
+	width, height int
+	bpp           int
+	topDown       bool
+	offset        int64 // start of the pixel data
+	stride        int   // bytes per file row, padded to 4
+	levels        [256]uint8
+}
+
+// Open parses the headers and palette of a BMP file.
+func Open(r io.ReaderAt) (*Decoder, error) {
+	var h [fileHeaderSize + 4]byte
+	if readAt(r, h[:], 0) != nil || h[0] != 'B' || h[1] != 'M' {
+		return nil, ErrFormat
+	}
+	le := binary.LittleEndian
+	d := &Decoder{r: r, offset: int64(le.Uint32(h[10:]))}
+	dibSize := int(le.Uint32(h[14:]))
+
+	var dib [infoHeaderSize]byte
+	entry := 4 // palette entry size: BGRx, or BGR for core headers
+	var colors int
+	switch {
+	case dibSize == coreHeaderSize:
+		if readAt(r, dib[:coreHeaderSize], fileHeaderSize) != nil {
+			return nil, ErrHeader
+		}
+		d.width = int(le.Uint16(dib[4:]))
+		d.height = int(le.Uint16(dib[6:]))
+		d.bpp = int(le.Uint16(dib[10:]))
+		entry = 3
+	case dibSize >= infoHeaderSize:
+		if readAt(r, dib[:], fileHeaderSize) != nil {
+			return nil, ErrHeader
+		}
+		d.width = int(int32(le.Uint32(dib[4:])))
+		d.height = int(int32(le.Uint32(dib[8:])))
+		d.bpp = int(le.Uint16(dib[14:]))
+		if le.Uint32(dib[16:]) != 0 { // BI_RGB
+			return nil, ErrUnsupported
+		}
+		colors = int(le.Uint32(dib[32:]))
+	default:
+		return nil, ErrHeader
+	}
+	if d.height < 0 {
+		d.height = -d.height
+		d.topDown = true
+	}
+	if d.bpp != 1 && d.bpp != 4 && d.bpp != 8 {
+		return nil, ErrUnsupported
+	}
+	if d.width <= 0 || d.height <= 0 || d.width > maxSize || d.height > maxSize {
+		return nil, ErrHeader
+	}
+	if colors == 0 || colors > 1<<d.bpp {
+		colors = 1 << d.bpp
+	}
+	d.stride = (d.width*d.bpp + 31) / 32 * 4
+
+	// Read the palette a few entries at a time to keep the stack small.
+	var p [16 * 4]byte
+	pos := int64(fileHeaderSize + dibSize)
+	for i := 0; i < colors; {
+		n := min(colors-i, 16)
+		if readAt(r, p[:n*entry], pos) != nil {
+			return nil, ErrHeader
+		}
+		for j := 0; j < n; j++ {
+			e := p[j*entry:]
+			y, _, _ := color.RGBToYCbCr(e[2], e[1], e[0])
+			d.levels[i+j] = epd47.GrayToLevel(y)
+		}
+		i += n
+		pos += int64(n * entry)
+	}
+	if d.offset < pos {
+		return nil, ErrHeader
+	}
+	return d, nil
+}
+
+// readAt fills p from off; io.EOF with a full read is fine.
+func readAt(r io.ReaderAt, p []byte, off int64) error {
+	n, err := r.ReadAt(p, off)
+	if n == len(p) {
+		return nil
+	}
+	if err == nil {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// Width returns the image width in pixels.
+func (d *Decoder) Width() int { return d.width }
+
+// Height returns the image height in pixels.
+func (d *Decoder) Height() int { return d.height }
+
+// BPP returns the stored bits per pixel (1, 4 or 8).
+func (d *Decoder) BPP() int { return d.bpp }
+
+// TopDown reports whether the file stores its top row first.
+func (d *Decoder) TopDown() bool { return d.topDown }
+
+// Level returns the ink level of palette index i.
+func (d *Decoder) Level(i uint8) uint8 { return d.levels[i] }
+
+// Rows4bpp returns a reader producing packed 4bpp rows for DrawRows4bpp.
+func (d *Decoder) Rows4bpp() *Reader { return d.reader(4) }
+
+// Rows1bpp returns a reader producing packed 1bpp rows for DrawRows1bpp;
+// levels 8 and darker are inked.
+func (d *Decoder) Rows1bpp() *Reader { return d.reader(1) }
+
+func (d *Decoder) reader(bpp int) *Reader {
+	return &Reader{d: d, bpp: bpp, scratch: make([]byte, d.stride)}
+}
+
+// Bitmap4bpp decodes the whole image.
+func (d *Decoder) Bitmap4bpp() (*epd47.Bitmap4bpp, error) {
+	b := epd47.NewBitmap4bpp(d.width, d.height)
+	r := d.Rows4bpp()
+	for y := 0; y < d.height; y++ {
+		if err := r.ReadRow(y, b.Pix[y*b.Stride:(y+1)*b.Stride]); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// Bitmap1bpp decodes the whole image as 1bpp.
+func (d *Decoder) Bitmap1bpp() (*epd47.Bitmap1bpp, error) {
+	b := epd47.NewBitmap1bpp(d.width, d.height)
+	r := d.Rows1bpp()
+	for y := 0; y < d.height; y++ {
+		if err := r.ReadRow(y, b.Pix[y*b.Stride:(y+1)*b.Stride]); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// Reader streams rows at an output depth of 1 or 4 bits per pixel in any
+// order.
+type Reader struct {
+	d       *Decoder
+	bpp     int
+	scratch []byte
+}
+
+// Stride returns the number of bytes per output row.
+func (r *Reader) Stride() int { return (r.d.width*r.bpp + 7) / 8 }
+
+// ReadRow fills dst with output row y (0 is the top). It implements
+// epd47.RowSource.
+func (r *Reader) ReadRow(y int, dst []byte) error {
+	d := r.d
+	if y < 0 || y >= d.height || len(dst) < r.Stride() {
+		return ErrRow
+	}
+	fy := y
+	if !d.topDown {
+		fy = d.height - 1 - y
+	}
+	if readAt(d.r, r.scratch, d.offset+int64(fy)*int64(d.stride)) != nil {
+		return ErrData
+	}
+
+	clear(dst[:r.Stride()])
+	shift, mask := 8-d.bpp, uint8(1<<d.bpp-1)
+	for x := 0; x < d.width; x++ {
+		bit := x * d.bpp
+		i := r.scratch[bit>>3] >> (shift - bit&7) & mask
+		l := d.levels[i]
+		if r.bpp == 4 {
+			dst[x>>1] |= l << (4 - 4*(x&1))
+		} else if l >= 8 {
+			dst[x>>3] |= 0x80 >> (x & 7)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package bmp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+)
+
+// makeBMP builds an uncompressed BITMAPINFOHEADER file. index(x, y) gives
+// the palette index of each pixel, y counting from the top.
+func makeBMP(w, h, bpp int, topDown bool, palette [][3]uint8, index func(x, y int) uint8) []byte {
+	stride := (w*bpp + 31) / 32 * 4
+	offset := 14 + 40 + 4*len(palette)
+	b := make([]byte, offset+stride*h)
+	le := binary.LittleEndian
+	copy(b, "BM")
+	le.PutUint32(b[2:], uint32(len(b)))
+	le.PutUint32(b[10:], uint32(offset))
+	le.PutUint32(b[14:], 40)
+	le.PutUint32(b[18:], uint32(w))
+	hh := int32(h)
+	if topDown {
+		hh = -hh
+	}
+	le.PutUint32(b[22:], uint32(hh))
+	le.PutUint16(b[26:], 1)
+	le.PutUint16(b[28:], uint16(bpp))
+	le.PutUint32(b[46:], uint32(len(palette)))
+	for i, c := range palette {
+		copy(b[54+4*i:], []byte{c[2], c[1], c[0], 0}) // BGRx
+	}
+	for y := 0; y < h; y++ {
+		fy := h - 1 - y
+		if topDown {
+			fy = y
+		}
+		row := b[offset+fy*stride:]
+		for x := 0; x < w; x++ {
+			bit := x * bpp
+			row[bit/8] |= index(x, y) << (8 - bpp - bit%8)
+		}
+	}
+	return b
+}
+
+// grays returns an n-entry palette from white to black.
+func grays(n int) [][3]uint8 {
+	p := make([][3]uint8, n)
+	for i := range p {
+		g := uint8(255 - i*255/(n-1))
+		p[i] = [3]uint8{g, g, g}
+	}
+	return p
+}
+
+func TestDecodeDepthsAndOrientation(t *testing.T) {
+	for _, bpp := range []int{1, 4, 8} {
+		for _, topDown := range []bool{false, true} {
+			n := 1 << bpp
+			idx := func(x, y int) uint8 { return uint8((x + 3*y) % n) }
+			d, err := Open(bytes.NewReader(makeBMP(13, 5, bpp, topDown, grays(n), idx)))
+			if err != nil {
+				t.Fatalf("%dbpp: Open failed: %v", bpp, err)
+			}
+			if d.Width() != 13 || d.Height() != 5 || d.BPP() != bpp || d.TopDown() != topDown {
+				t.Fatalf("Unexpected header %dx%d %dbpp topDown=%v", d.Width(), d.Height(), d.BPP(), d.TopDown())
+			}
+			b, err := d.Bitmap4bpp()
+			if err != nil {
+				t.Fatalf("%dbpp: decode failed: %v", bpp, err)
+			}
+			for y := 0; y < 5; y++ {
+				for x := 0; x < 13; x++ {
+					want := epd47.GrayToLevel(uint8(255 - int(idx(x, y))*255/(n-1)))
+					if got := b.Level(x, y); got != want {
+						t.Fatalf("%dbpp topDown=%v: pixel %d,%d expected level %d, got %d", bpp, topDown, x, y, want, got)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestPaletteByLuminance(t *testing.T) {
+	// Black first, as most 1-bit files store it, then white, blue and yellow.
+	pal := [][3]uint8{{0, 0, 0}, {255, 255, 255}, {0, 0, 255}, {255, 255, 0}}
+	d, err := Open(bytes.NewReader(makeBMP(4, 1, 4, false, pal, func(x, y int) uint8 { return uint8(x) })))
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	if d.Level(0) != 15 || d.Level(1) != 0 {
+		t.Errorf("Expected black->15 and white->0, got %d %d", d.Level(0), d.Level(1))
+	}
+	if d.Level(2) < 12 || d.Level(3) > 3 {
+		t.Errorf("Expected dark blue and light yellow, got %d %d", d.Level(2), d.Level(3))
+	}
+
+	m, err := d.Bitmap1bpp()
+	if err != nil {
+		t.Fatalf("Bitmap1bpp failed: %v", err)
+	}
+	if m.Pix[0] != 0xA0 {
+		t.Errorf("Expected black and blue inked, got % x", m.Pix)
+	}
+}
+
+func TestRowsRereadable(t *testing.T) {
+	data := makeBMP(8, 4, 8, false, grays(256), func(x, y int) uint8 { return uint8(y * 80) })
+	d, _ := Open(bytes.NewReader(data))
+	r := d.Rows4bpp()
+	row := make([]byte, r.Stride())
+	for _, y := range []int{3, 0, 2, 0} {
+		if err := r.ReadRow(y, row); err != nil {
+			t.Fatalf("ReadRow(%d) failed: %v", y, err)
+		}
+		l := d.Level(uint8(y * 80))
+		if row[0] != l<<4|l {
+			t.Errorf("Row %d: expected level %d, got % x", y, l, row)
+		}
+	}
+	if err := r.ReadRow(4, row); err != ErrRow {
+		t.Errorf("Expected ErrRow, got %v", err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	good := makeBMP(8, 2, 1, false, grays(2), func(x, y int) uint8 { return 1 })
+
+	set := func(off int, v uint32) []byte {
+		b := append([]byte(nil), good...)
+		binary.LittleEndian.PutUint32(b[off:], v)
+		return b
+	}
+	set16 := func(off int, v uint16) []byte {
+		b := append([]byte(nil), good...)
+		binary.LittleEndian.PutUint16(b[off:], v)
+		return b
+	}
+	cases := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"png", []byte("\x89PNG\r\n\x1a\n"), ErrFormat},
+		{"short", good[:10], ErrFormat},
+		{"rle8", set(30, 1), ErrUnsupported},
+		{"24-bit", set16(28, 24), ErrUnsupported},
+		{"dib size", set(14, 20), ErrHeader},
+		{"zero width", set(18, 0), ErrHeader},
+		{"offset in palette", set(10, 20), ErrHeader},
+	}
+	for _, c := range cases {
+		if _, err := Open(bytes.NewReader(c.data)); err != c.want {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, err)
+		}
+	}
+
+	// Pixel data cut short fails when the row is read.
+	d, err := Open(bytes.NewReader(good[:len(good)-2]))
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	if _, err := d.Bitmap4bpp(); err != ErrData {
+		t.Errorf("Expected ErrData, got %v", err)
+	}
+}
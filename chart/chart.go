@@ -0,0 +1,304 @@
+// Package chart renders time series into a 4bpp region for e-paper
+// dashboards: line, area and bar series with axes, tick marks, labels and
+// gridlines, and a value axis that scales itself to the data.
+//
+// Rendering suits the panel rather than a screen: lines are 1px wide and
+// not anti-aliased, labels are thresholded to solid ink unless AntiAlias is
+// set, and gray levels are used only for flat fills and gridlines, which
+// stay stable across partial updates.
+//
+// A Chart is a widget.Widget: adding points marks it dirty, so a
+// widget.Screen repaints only the chart's area.
+package chart
+
+import (
+	"image"
+	"time"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+	"github.com/abaschen/tinygo-epd47-s3/font"
+	"github.com/abaschen/tinygo-epd47-s3/widget"
+)
+
+// Kind selects how a series is drawn.
+type Kind uint8
+
+const (
+	Line Kind = iota // 1px polyline
+	Area             // polyline over a gray fill down to the plot bottom
+	Bars             // one bar per point, from zero or the plot bottom
+)
+
+// Point is one sample of a series.
+type Point struct {
+	T time.Time
+	V float32
+}
+
+// Series is a named sequence of points in time order.
+type Series struct {
+	Name string
+	Kind Kind
+	Ink  uint8 // line level
+	Fill uint8 // area and bar level
+	// MaxLen drops the oldest points beyond this many; 0 keeps all.
+	MaxLen int
+
+	points []Point
+	chart  *Chart
+}
+
+// Points returns the samples. The slice is the series' own.
+func (s *Series) Points() []Point { return s.points }
+
+// Append adds a sample after the existing ones and marks the chart dirty.
+func (s *Series) Append(t time.Time, v float32) {
+	if s.MaxLen > 0 && len(s.points) >= s.MaxLen {
+		n := copy(s.points, s.points[len(s.points)-s.MaxLen+1:])
+		s.points = s.points[:n]
+	}
+	s.points = append(s.points, Point{t, v})
+	s.invalidate()
+}
+
+// SetPoints replaces the samples with a copy of ps.
+func (s *Series) SetPoints(ps []Point) {
+	s.points = append(s.points[:0], ps...)
+	s.invalidate()
+}
+
+func (s *Series) invalidate() {
+	if s.chart != nil {
+		s.chart.Invalidate()
+	}
+}
+
+// Chart plots series against time.
+type Chart struct {
+	widget.Base
+
+	// YMin and YMax fix the value axis when YMin < YMax; otherwise it is
+	// widened from the data to whole tick steps.
+	YMin, YMax float32
+	// XMin and XMax fix the time axis when both are set, for example to
+	// the last 24 hours; points outside are not drawn. Otherwise the axis
+	// spans the data.
+	XMin, XMax time.Time
+
+	YTicks int // target number of value intervals, 0 for 4
+	XTicks int // target number of time intervals, 0 for 4
+	// TimeLayout formats time labels; empty picks one from the tick step.
+	TimeLayout string
+
+	Font      *font.Font // nil selects font.DejaVuSans16
+	AntiAlias bool       // draw labels anti-aliased instead of solid
+	Axis      uint8      // axis, tick and label level
+	Grid      uint8      // gridline level, 0 for none
+
+	series []*Series
+}
+
+// New returns an empty chart with black axes and light gray gridlines.
+func New() *Chart {
+	return &Chart{Axis: 15, Grid: 3}
+}
+
+// AddSeries creates a series drawn on top of the existing ones. Lines are
+// black; areas and bars get a mid-gray fill.
+func (c *Chart) AddSeries(name string, kind Kind) *Series {
+	s := &Series{Name: name, Kind: kind, Ink: 15, Fill: 6, chart: c}
+	c.series = append(c.series, s)
+	c.Invalidate()
+	return s
+}
+
+// Series returns the series, bottom first. The slice is the chart's own.
+func (c *Chart) Series() []*Series { return c.series }
+
+// ranges returns the time span and the value span of the data, or false
+// if there is none.
+func (c *Chart) ranges() (t0, t1 time.Time, v0, v1 float32, ok bool) {
+	bars := false
+	for _, s := range c.series {
+		for _, p := range s.points {
+			if !ok {
+				t0, t1, v0, v1, ok = p.T, p.T, p.V, p.V, true
+				continue
+			}
+			if p.T.Before(t0) {
+				t0 = p.T
+			}
+			if p.T.After(t1) {
+				t1 = p.T
+			}
+			v0, v1 = min(v0, p.V), max(v1, p.V)
+		}
+		bars = bars || s.Kind == Bars
+	}
+	if bars {
+		v0, v1 = min(v0, 0), max(v1, 0) // bars grow from zero
+	}
+	if !c.XMin.IsZero() && !c.XMax.IsZero() {
+		t0, t1 = c.XMin, c.XMax
+	}
+	if c.YMin < c.YMax {
+		v0, v1 = c.YMin, c.YMax
+	}
+	return
+}
+
+// Draw implements widget.Widget.
+func (c *Chart) Draw(dst *epd47.Bitmap4bpp, r image.Rectangle) {
+	f := c.Font
+	if f == nil {
+		f = font.DejaVuSans16
+	}
+	t0, t1, v0, v1, ok := c.ranges()
+	if !ok {
+		return
+	}
+
+	// Value axis.
+	yTicks := c.YTicks
+	if yTicks <= 0 {
+		yTicks = 4
+	}
+	lo, hi, step := niceRange(float64(v0), float64(v1), yTicks)
+	if c.YMin < c.YMax {
+		lo, hi = float64(c.YMin), float64(c.YMax)
+		step = niceStep(hi-lo, yTicks)
+	}
+	vt := valueTicks(lo, hi, step)
+	labels := make([]string, len(vt))
+	lw := 0
+	for i, v := range vt {
+		labels[i] = formatValue(v, step)
+		lw = max(lw, f.Width(labels[i]))
+	}
+
+	// Time axis.
+	xTicks := c.XTicks
+	if xTicks <= 0 {
+		xTicks = 4
+	}
+	tstep := timeStep(t1.Sub(t0), xTicks)
+	tt := timeTicks(t0, t1, tstep)
+	layout := c.TimeLayout
+	if layout == "" {
+		layout = timeLayout(tstep)
+	}
+
+	// Plot area: room for value labels on the left, time labels below and
+	// half a label around the ends.
+	const tick = 3
+	p := image.Rect(r.Min.X+lw+tick+2, r.Min.Y+f.Ascent/2+1, r.Max.X-f.Width(t1.Format(layout))/2-1, r.Max.Y-f.LineHeight-tick-1)
+	if p.Dx() < 2 || p.Dy() < 2 {
+		return
+	}
+	px := func(t time.Time) int {
+		span := t1.Sub(t0)
+		if span <= 0 {
+			return p.Min.X + p.Dx()/2
+		}
+		return p.Min.X + int(float64(t.Sub(t0))/float64(span)*float64(p.Dx()-1)+0.5)
+	}
+	py := func(v float64) int {
+		v = min(max(v, lo), hi)
+		return p.Max.Y - 1 - int((v-lo)/(hi-lo)*float64(p.Dy()-1)+0.5)
+	}
+
+	var text font.Target = crisp{dst}
+	if c.AntiAlias {
+		text = dst
+	}
+
+	// Gridlines first so data and axes cover them.
+	for i, v := range vt {
+		y := py(v)
+		if c.Grid != 0 {
+			dst.HLine(p.Min.X, p.Max.X-1, y, c.Grid)
+		}
+		dst.HLine(p.Min.X-tick, p.Min.X-1, y, c.Axis)
+		x := p.Min.X - tick - 2 - f.Width(labels[i])
+		font.Draw(text, f, x, y+f.Ascent/2, labels[i], c.Axis)
+	}
+	for _, t := range tt {
+		x := px(t)
+		if c.Grid != 0 {
+			dst.VLine(x, p.Min.Y, p.Max.Y-1, c.Grid)
+		}
+		dst.VLine(x, p.Max.Y, p.Max.Y+tick-1, c.Axis)
+		s := t.Format(layout)
+		font.Draw(text, f, x-f.Width(s)/2, p.Max.Y+tick+1+f.Ascent, s, c.Axis)
+	}
+
+	for _, s := range c.series {
+		c.drawSeries(dst, window(s.points, t0, t1), s, p, px, py, lo, hi)
+	}
+
+	dst.VLine(p.Min.X-1, p.Min.Y, p.Max.Y, c.Axis)
+	dst.HLine(p.Min.X-1, p.Max.X-1, p.Max.Y, c.Axis)
+}
+
+// window returns the points of ps from t0 to t1; ps is in time order.
+func window(ps []Point, t0, t1 time.Time) []Point {
+	for len(ps) > 0 && ps[0].T.Before(t0) {
+		ps = ps[1:]
+	}
+	for len(ps) > 0 && ps[len(ps)-1].T.After(t1) {
+		ps = ps[:len(ps)-1]
+	}
+	return ps
+}
+
+// drawSeries plots the points ps of series s inside plot area p, clipping
+// fills to it; ps must lie in the time span, which keeps lines inside.
+func (c *Chart) drawSeries(dst *epd47.Bitmap4bpp, ps []Point, s *Series, p image.Rectangle, px func(time.Time) int, py func(float64) int, lo, hi float64) {
+	if len(ps) == 0 {
+		return
+	}
+	bottom := p.Max.Y - 1
+	switch s.Kind {
+	case Bars:
+		// Bars share the plot width evenly, with a 1px gap when there
+		// is room.
+		w := max(1, p.Dx()/len(ps))
+		if w > 2 {
+			w--
+		}
+		base := py(min(max(0, lo), hi))
+		for _, pt := range ps {
+			x := min(max(px(pt.T)-w/2, p.Min.X), p.Max.X-w)
+			y := py(float64(pt.V))
+			dst.FillRect(image.Rect(x, min(y, base), x+w, max(y, base)+1).Intersect(p), s.Fill)
+		}
+		return
+	case Area:
+		x0, y0 := px(ps[0].T), py(float64(ps[0].V))
+		dst.VLine(x0, y0, bottom, s.Fill)
+		for _, pt := range ps[1:] {
+			x1, y1 := px(pt.T), py(float64(pt.V))
+			for x := max(x0+1, p.Min.X); x <= min(x1, p.Max.X-1); x++ {
+				dst.VLine(x, y0+(y1-y0)*(x-x0)/(x1-x0), bottom, s.Fill)
+			}
+			x0, y0 = x1, y1
+		}
+	}
+	x0, y0 := px(ps[0].T), py(float64(ps[0].V))
+	dst.SetLevel(x0, y0, s.Ink)
+	for _, pt := range ps[1:] {
+		x1, y1 := px(pt.T), py(float64(pt.V))
+		dst.Line(x0, y0, x1, y1, s.Ink)
+		x0, y0 = x1, y1
+	}
+}
+
+// crisp thresholds glyph coverage into solid pixels, which avoids gray
+// fringes that shift between refreshes on e-paper.
+type crisp struct{ b *epd47.Bitmap4bpp }
+
+func (c crisp) BlendLevel(x, y int, ink, alpha uint8) {
+	if alpha >= 8 {
+		c.b.SetLevel(x, y, ink)
+	}
+}
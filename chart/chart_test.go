@@ -0,0 +1,174 @@
+package chart
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+	"github.com/abaschen/tinygo-epd47-s3/widget"
+)
+
+var _ widget.Widget = New()
+
+func TestTicks(t *testing.T) {
+	lo, hi, step := niceRange(3.2, 27.9, 4)
+	if lo != 0 || hi != 30 || step != 10 {
+		t.Errorf("Expected 0..30 in steps of 10, got %v..%v step %v", lo, hi, step)
+	}
+	if got := valueTicks(lo, hi, step); len(got) != 4 {
+		t.Errorf("Expected 4 ticks, got %v", got)
+	}
+	if _, _, step := niceRange(0.31, 0.42, 5); step != 0.05 {
+		t.Errorf("Expected a step of 0.05, got %v", step)
+	}
+	for v, want := range map[float64]string{0.25: "0.25", 1e-12: "0.00", -1.5: "-1.50"} {
+		if got := formatValue(v, 0.05); got != want {
+			t.Errorf("formatValue(%v): expected %q, got %q", v, want, got)
+		}
+	}
+	if lo, hi, _ := niceRange(5, 5, 4); !(lo < 5 && hi > 5) {
+		t.Errorf("Expected a flat series to get a range around it, got %v..%v", lo, hi)
+	}
+
+	t0 := time.Date(2024, 5, 1, 9, 47, 0, 0, time.UTC)
+	step6 := timeStep(6*time.Hour, 4)
+	if step6 != 2*time.Hour {
+		t.Errorf("Expected 2h ticks for 6h, got %v", step6)
+	}
+	ts := timeTicks(t0, t0.Add(6*time.Hour), step6)
+	if len(ts) != 3 || ts[0].Hour() != 10 || ts[0].Minute() != 0 {
+		t.Errorf("Expected ticks on whole hours from 10:00, got %v", ts)
+	}
+	if timeLayout(step6) != "15:04" || timeLayout(48*time.Hour) != "Jan 2" {
+		t.Error("Unexpected time layouts")
+	}
+}
+
+// level counts pixels of b in r at exactly level l.
+func level(b *epd47.Bitmap4bpp, r image.Rectangle, l uint8) int {
+	n := 0
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			if b.Level(x, y) == l {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func TestDraw(t *testing.T) {
+	c := New()
+	s := c.AddSeries("temp", Area)
+	t0 := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i <= 12; i++ {
+		s.Append(t0.Add(time.Duration(i)*time.Hour), float32(i+3))
+	}
+	b := epd47.NewBitmap4bpp(300, 200)
+	c.Draw(b, b.Bounds())
+
+	// Solid labels and lines only: no anti-aliased levels.
+	for l := uint8(1); l < 15; l++ {
+		if l != c.Grid && l != s.Fill && level(b, b.Bounds(), l) != 0 {
+			t.Errorf("Unexpected level %d in the chart", l)
+		}
+	}
+	if level(b, b.Bounds(), s.Fill) == 0 || level(b, b.Bounds(), c.Grid) == 0 {
+		t.Error("Expected a gray area fill and gridlines")
+	}
+	// Value labels at the left, time labels at the bottom.
+	if level(b, image.Rect(0, 0, 20, 180), 15) == 0 || level(b, image.Rect(40, 185, 300, 200), 15) == 0 {
+		t.Error("Expected axis labels")
+	}
+
+	// Auto-scaled to 0..15, the line rises to the top right.
+	top := -1
+	for y := 0; y < b.Height && top < 0; y++ {
+		if b.Level(270, y) == 15 || b.Level(280, y) == 15 {
+			top = y
+		}
+	}
+	if top < 0 || top > 20 {
+		t.Errorf("Expected the line near the top on the right, got y=%d", top)
+	}
+
+	// Fixed scale clips; MaxLen drops old points.
+	s.MaxLen = 3
+	s.Append(t0.Add(13*time.Hour), 100)
+	if len(s.Points()) != 3 || s.Points()[0].V != 14 {
+		t.Errorf("Expected the last 3 points, got %v", s.Points())
+	}
+	c.YMin, c.YMax = 0, 20
+	b.Fill(0)
+	c.Draw(b, b.Bounds())
+	if level(b, image.Rect(0, 0, 300, 3), 15) > 40 {
+		t.Error("Expected the clipped value to stay inside the plot")
+	}
+}
+
+func TestFixedWindowStaysInside(t *testing.T) {
+	t0 := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	for _, kind := range []Kind{Line, Area, Bars} {
+		c := New()
+		s := c.AddSeries("temp", kind)
+		for i := 0; i < 48; i++ {
+			s.Append(t0.Add(time.Duration(i)*time.Hour), float32(i%10))
+		}
+		// The last 24 hours: the first half of the points is older.
+		c.XMin, c.XMax = t0.Add(23*time.Hour), t0.Add(47*time.Hour)
+		b := epd47.NewBitmap4bpp(900, 200)
+		r := image.Rect(300, 0, 600, 200)
+		c.Draw(b, r)
+		if level(b, r, s.Fill) == 0 && level(b, r, s.Ink) == 0 {
+			t.Errorf("Expected kind %d drawn inside its area", kind)
+		}
+		if n := 900*200 - level(b, b.Bounds(), 0) - (r.Dx()*r.Dy() - level(b, r, 0)); n != 0 {
+			t.Errorf("Expected nothing outside the chart for kind %d, got %d pixels", kind, n)
+		}
+	}
+}
+
+func TestBarsAndDirty(t *testing.T) {
+	c := New()
+	c.Grid = 0
+	s := c.AddSeries("rain", Bars)
+	t0 := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	s.SetPoints([]Point{{t0, 4}, {t0.Add(time.Hour), 0}, {t0.Add(2 * time.Hour), 8}})
+	b := epd47.NewBitmap4bpp(200, 120)
+	c.Draw(b, b.Bounds())
+	if level(b, b.Bounds(), s.Fill) == 0 {
+		t.Fatal("Expected filled bars")
+	}
+
+	fd := &nullDisplay{}
+	scr := widget.NewScreen(fd)
+	c.SetBounds(image.Rect(0, 0, 200, 120))
+	scr.Add(c)
+	scr.Update()
+	if c.Dirty() {
+		t.Error("Expected the chart clean after an update")
+	}
+	s.Append(t0.Add(3*time.Hour), 2)
+	if !c.Dirty() {
+		t.Error("Expected new points to repaint the chart")
+	}
+	if n, _ := scr.Update(); n != 1 {
+		t.Error("Expected new points to repaint the chart")
+	}
+
+	// No data draws nothing.
+	empty := epd47.NewBitmap4bpp(50, 50)
+	New().Draw(empty, empty.Bounds())
+	if level(empty, empty.Bounds(), 0) != 50*50 {
+		t.Error("Expected an empty chart to draw nothing")
+	}
+}
+
+type nullDisplay struct{}
+
+func (nullDisplay) Bounds() image.Rectangle                 { return image.Rect(0, 0, 960, 540) }
+func (nullDisplay) ClearArea(r image.Rectangle, cycles int) {}
+func (nullDisplay) DrawRows4bpp(x, y, w, h int, _ epd47.RowSource, _ epd47.DrawMode) error {
+	return nil
+}
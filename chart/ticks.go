@@ -0,0 +1,106 @@
+package chart
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// niceStep returns a 1, 2 or 5 times a power of ten step giving at most
+// about n intervals over span.
+func niceStep(span float64, n int) float64 {
+	if span <= 0 || n <= 0 {
+		return 1
+	}
+	raw := span / float64(n)
+	mag := math.Pow(10, math.Floor(math.Log10(raw)))
+	for _, m := range []float64{1, 2, 5} {
+		if m*mag >= raw {
+			return m * mag
+		}
+	}
+	return 10 * mag
+}
+
+// niceRange widens lo..hi outwards to multiples of a nice step and returns
+// the new bounds and the step.
+func niceRange(lo, hi float64, n int) (float64, float64, float64) {
+	if hi < lo {
+		lo, hi = hi, lo
+	}
+	if hi == lo {
+		d := math.Abs(lo) / 10
+		if d == 0 {
+			d = 1
+		}
+		lo, hi = lo-d, hi+d
+	}
+	step := niceStep(hi-lo, n)
+	return math.Floor(lo/step) * step, math.Ceil(hi/step) * step, step
+}
+
+// valueTicks returns the multiples of step from lo to hi.
+func valueTicks(lo, hi, step float64) []float64 {
+	var ts []float64
+	for v := math.Ceil(lo/step-1e-9) * step; v <= hi+step*1e-9; v += step {
+		ts = append(ts, v)
+	}
+	return ts
+}
+
+// formatValue prints v with as many decimals as step needs.
+func formatValue(v, step float64) string {
+	prec := 0
+	if step < 1 {
+		prec = int(math.Ceil(-math.Log10(step) - 1e-9))
+	}
+	if math.Abs(v) < step*1e-6 {
+		v = 0 // avoid "-0"
+	}
+	return strconv.FormatFloat(v, 'f', prec, 64)
+}
+
+// timeSteps are the tick spacings tried for the time axis.
+var timeSteps = []time.Duration{
+	time.Second, 5 * time.Second, 15 * time.Second, 30 * time.Second,
+	time.Minute, 5 * time.Minute, 15 * time.Minute, 30 * time.Minute,
+	time.Hour, 2 * time.Hour, 3 * time.Hour, 6 * time.Hour, 12 * time.Hour,
+	24 * time.Hour, 2 * 24 * time.Hour, 7 * 24 * time.Hour, 30 * 24 * time.Hour,
+}
+
+// timeStep returns the smallest step giving at most n intervals over span.
+func timeStep(span time.Duration, n int) time.Duration {
+	for _, s := range timeSteps {
+		if span <= s*time.Duration(max(n, 1)) {
+			return s
+		}
+	}
+	return timeSteps[len(timeSteps)-1]
+}
+
+// timeTicks returns the instants from lo to hi on multiples of step in
+// lo's time zone, so hour ticks fall on whole local hours.
+func timeTicks(lo, hi time.Time, step time.Duration) []time.Time {
+	_, off := lo.Zone()
+	z := time.Duration(off) * time.Second
+	t := lo.Add(z).Truncate(step).Add(-z)
+	if t.Before(lo) {
+		t = t.Add(step)
+	}
+	var ts []time.Time
+	for ; !t.After(hi); t = t.Add(step) {
+		ts = append(ts, t)
+	}
+	return ts
+}
+
+// timeLayout picks a label layout matching the tick step.
+func timeLayout(step time.Duration) string {
+	switch {
+	case step < time.Minute:
+		return "15:04:05"
+	case step < 24*time.Hour:
+		return "15:04"
+	}
+	return "Jan 2"
+}
@@ -0,0 +1,278 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+	xdraw "golang.org/x/image/draw"
+)
+
+// options control the conversion pipeline.
+type options struct {
+	BPP           int
+	Width, Height int
+	Fit           string
+	Crop          *image.Rectangle
+	Gamma         float64
+	Dither        string
+	Invert        bool
+	Calibration   *epd47.Calibration // nil for evenly spaced levels
+}
+
+// packedImage is a converted image in the driver's packed layout.
+type packedImage struct {
+	BPP           int
+	Width, Height int
+	Pix           []byte
+}
+
+func loadImage(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	return img, err
+}
+
+func parseCrop(s string) (image.Rectangle, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 4 {
+		return image.Rectangle{}, fmt.Errorf("crop wants x,y,w,h, got %q", s)
+	}
+	var v [4]int
+	for i, p := range parts {
+		n, err := strconv.Atoi(strings.TrimSpace(p))
+		if err != nil {
+			return image.Rectangle{}, fmt.Errorf("bad crop %q: %v", s, err)
+		}
+		v[i] = n
+	}
+	if v[2] <= 0 || v[3] <= 0 {
+		return image.Rectangle{}, fmt.Errorf("bad crop %q: empty area", s)
+	}
+	return image.Rect(v[0], v[1], v[0]+v[2], v[1]+v[3]), nil
+}
+
+// convert runs crop, fit, gray conversion, gamma and quantization.
+func convert(src image.Image, opt options) (*packedImage, error) {
+	if opt.BPP != 1 && opt.BPP != 4 {
+		return nil, fmt.Errorf("bpp must be 1 or 4, got %d", opt.BPP)
+	}
+	if opt.Width <= 0 || opt.Height <= 0 {
+		return nil, fmt.Errorf("target size must be positive, got %dx%d", opt.Width, opt.Height)
+	}
+	if opt.Crop != nil {
+		r := opt.Crop.Add(src.Bounds().Min).Intersect(src.Bounds())
+		if r.Empty() {
+			return nil, fmt.Errorf("crop %v is outside the image", *opt.Crop)
+		}
+		src = subImage(src, r)
+	}
+
+	gray, err := fit(src, opt.Width, opt.Height, opt.Fit)
+	if err != nil {
+		return nil, err
+	}
+	applyTone(gray, opt.Gamma, opt.Invert)
+
+	levels := 16
+	if opt.BPP == 1 {
+		levels = 2
+	}
+	method := epd47.DitherNone
+	if opt.Dither != "" {
+		m, ok := epd47.ParseDitherMethod(opt.Dither)
+		if !ok {
+			return nil, fmt.Errorf("unknown dither %q", opt.Dither)
+		}
+		method = m
+	}
+	d := epd47.NewDitherer(method, opt.Width, levels)
+	if opt.Calibration != nil {
+		d.SetCalibration(opt.Calibration)
+	}
+	q := quantize(gray, d)
+	return pack(q, opt.BPP), nil
+}
+
+// loadCalibration reads a calibration blob written by
+// Calibration.MarshalBinary, or parses 16 comma-separated gray values.
+func loadCalibration(s string) (*epd47.Calibration, error) {
+	var c epd47.Calibration
+	if strings.Contains(s, ",") {
+		parts := strings.Split(s, ",")
+		if len(parts) != len(c) {
+			return nil, fmt.Errorf("calibration wants %d grays, got %d", len(c), len(parts))
+		}
+		for i, p := range parts {
+			v, err := strconv.ParseUint(strings.TrimSpace(p), 10, 8)
+			if err != nil {
+				return nil, fmt.Errorf("bad calibration %q: %v", s, err)
+			}
+			c[i] = uint8(v)
+		}
+		if err := c.Validate(); err != nil {
+			return nil, err
+		}
+		return &c, nil
+	}
+	b, err := os.ReadFile(s)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.UnmarshalBinary(b); err != nil {
+		return nil, fmt.Errorf("%s: %v", s, err)
+	}
+	return &c, nil
+}
+
+// subImage crops without copying when the image supports it.
+func subImage(img image.Image, r image.Rectangle) image.Image {
+	if s, ok := img.(interface {
+		SubImage(image.Rectangle) image.Image
+	}); ok {
+		return s.SubImage(r)
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, r.Dx(), r.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, r.Min, draw.Src)
+	return dst
+}
+
+// fit scales src into a w x h gray canvas on a white background.
+//
+//	contain  scale to fit inside, letterboxing with white
+//	cover    scale to fill, cropping the overflow around the center
+//	stretch  scale to exactly w x h, ignoring the aspect ratio
+//	none     no scaling, centered and cropped or padded
+func fit(src image.Image, w, h int, mode string) (*image.Gray, error) {
+	// Flatten transparency onto white before anything else.
+	sb := src.Bounds()
+	flat := image.NewGray(image.Rect(0, 0, sb.Dx(), sb.Dy()))
+	draw.Draw(flat, flat.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), src, sb.Min, draw.Over)
+
+	dst := image.NewGray(image.Rect(0, 0, w, h))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+
+	sw, sh := float64(sb.Dx()), float64(sb.Dy())
+	var r image.Rectangle
+	switch mode {
+	case "contain", "":
+		s := math.Min(float64(w)/sw, float64(h)/sh)
+		r = centered(w, h, int(math.Round(sw*s)), int(math.Round(sh*s)))
+	case "cover":
+		s := math.Max(float64(w)/sw, float64(h)/sh)
+		r = centered(w, h, int(math.Round(sw*s)), int(math.Round(sh*s)))
+	case "stretch":
+		r = dst.Bounds()
+	case "none":
+		r = centered(w, h, sb.Dx(), sb.Dy())
+		draw.Draw(dst, r, flat, image.Point{}, draw.Src)
+		return dst, nil
+	default:
+		return nil, fmt.Errorf("unknown fit mode %q", mode)
+	}
+	xdraw.CatmullRom.Scale(dst, r, flat, flat.Bounds(), draw.Src, nil)
+	return dst, nil
+}
+
+// centered returns a cw x ch rectangle centered in a w x h area.
+func centered(w, h, cw, ch int) image.Rectangle {
+	x := (w - cw) / 2
+	y := (h - ch) / 2
+	return image.Rect(x, y, x+cw, y+ch)
+}
+
+// applyTone applies gamma (out = in^gamma on a 0-1 scale) and inversion.
+func applyTone(g *image.Gray, gamma float64, invert bool) {
+	if gamma <= 0 {
+		gamma = 1
+	}
+	var lut [256]uint8
+	for i := range lut {
+		v := math.Pow(float64(i)/255, gamma) * 255
+		if invert {
+			v = 255 - v
+		}
+		lut[i] = uint8(math.Round(v))
+	}
+	for i, v := range g.Pix {
+		g.Pix[i] = lut[v]
+	}
+}
+
+// quantize dithers g row by row into ink levels (0 white .. levels-1 black).
+func quantize(g *image.Gray, d *epd47.Ditherer) [][]uint8 {
+	b := g.Bounds()
+	out := make([][]uint8, b.Dy())
+	for y := range out {
+		out[y] = make([]uint8, b.Dx())
+		d.Row(out[y], g.Pix[y*g.Stride:y*g.Stride+b.Dx()])
+	}
+	return out
+}
+
+// grayOf returns the gray value rendered for ink level idx.
+func grayOf(idx uint8, levels int) float64 {
+	return 255 - float64(idx)*255/float64(levels-1)
+}
+
+// pack converts ink levels into the driver's packed layout.
+func pack(q [][]uint8, bpp int) *packedImage {
+	h := len(q)
+	w := 0
+	if h > 0 {
+		w = len(q[0])
+	}
+	if bpp == 1 {
+		b := epd47.NewBitmap1bpp(w, h)
+		for y, row := range q {
+			for x, v := range row {
+				b.Set(x, y, v != 0)
+			}
+		}
+		return &packedImage{BPP: 1, Width: w, Height: h, Pix: b.Pix}
+	}
+	b := epd47.NewBitmap4bpp(w, h)
+	for y, row := range q {
+		for x, v := range row {
+			b.SetLevel(x, y, v)
+		}
+	}
+	return &packedImage{BPP: 4, Width: w, Height: h, Pix: b.Pix}
+}
+
+// previewImage renders what the panel shows for p.
+func previewImage(p *packedImage) *image.Gray {
+	g := image.NewGray(image.Rect(0, 0, p.Width, p.Height))
+	if p.BPP == 1 {
+		b := &epd47.Bitmap1bpp{Width: p.Width, Height: p.Height, Stride: (p.Width + 7) / 8, Pix: p.Pix}
+		for y := 0; y < p.Height; y++ {
+			for x := 0; x < p.Width; x++ {
+				if !b.Get(x, y) {
+					g.SetGray(x, y, color.Gray{Y: 255})
+				}
+			}
+		}
+		return g
+	}
+	b := &epd47.Bitmap4bpp{Width: p.Width, Height: p.Height, Stride: (p.Width + 1) / 2, Pix: p.Pix}
+	for y := 0; y < p.Height; y++ {
+		for x := 0; x < p.Width; x++ {
+			g.SetGray(x, y, color.Gray{Y: uint8(grayOf(b.Level(x, y), 16))})
+		}
+	}
+	return g
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/abaschen/tinygo-epd47-s3/asset"
+)
+
+// solid returns a w x h image filled with gray y.
+func solid(w, h int, y uint8) *image.Gray {
+	g := image.NewGray(image.Rect(0, 0, w, h))
+	for i := range g.Pix {
+		g.Pix[i] = y
+	}
+	return g
+}
+
+func TestConvertSizesAndPacking(t *testing.T) {
+	p, err := convert(solid(10, 10, 0), options{BPP: 4, Width: 20, Height: 10, Fit: "contain", Gamma: 1, Dither: "none"})
+	if err != nil {
+		t.Fatalf("convert failed: %v", err)
+	}
+	if p.Width != 20 || p.Height != 10 || len(p.Pix) != 10*10 {
+		t.Fatalf("Unexpected output %dx%d with %d bytes", p.Width, p.Height, len(p.Pix))
+	}
+	// Letterboxed: white (0) bars left and right of a black (15) square.
+	if p.Pix[0] != 0x00 || p.Pix[5] != 0xFF || p.Pix[9] != 0x00 {
+		t.Errorf("Unexpected contain layout % x", p.Pix[:10])
+	}
+
+	p, err = convert(solid(10, 10, 0), options{BPP: 1, Width: 20, Height: 10, Fit: "cover", Gamma: 1, Dither: "none"})
+	if err != nil {
+		t.Fatalf("convert failed: %v", err)
+	}
+	if len(p.Pix) != 3*10 || p.Pix[0] != 0xFF || p.Pix[2] != 0xF0 {
+		t.Errorf("Expected a fully inked 1bpp cover, got % x", p.Pix[:3])
+	}
+}
+
+func TestDitheringPreservesTone(t *testing.T) {
+	// 50% gray dithered to 1bpp inks about half the pixels.
+	p, err := convert(solid(64, 64, 128), options{BPP: 1, Width: 64, Height: 64, Fit: "none", Gamma: 1, Dither: "fs"})
+	if err != nil {
+		t.Fatalf("convert failed: %v", err)
+	}
+	on := 0
+	for _, b := range p.Pix {
+		for ; b != 0; b &= b - 1 {
+			on++
+		}
+	}
+	if on < 64*64*45/100 || on > 64*64*55/100 {
+		t.Errorf("Expected about half of the pixels inked, got %d of %d", on, 64*64)
+	}
+
+	for _, d := range []string{"atkinson", "bayer4", "bayer8", "blue-noise"} {
+		if _, err := convert(solid(8, 8, 128), options{BPP: 4, Width: 8, Height: 8, Fit: "none", Gamma: 1, Dither: d}); err != nil {
+			t.Errorf("Dither %s failed: %v", d, err)
+		}
+	}
+	if _, err := convert(solid(8, 8, 128), options{BPP: 4, Width: 8, Height: 8, Fit: "none", Gamma: 1, Dither: "halftone"}); err == nil {
+		t.Error("Expected an error for an unknown dither")
+	}
+
+	// Without dithering the same gray collapses to one level.
+	p, _ = convert(solid(8, 8, 128), options{BPP: 1, Width: 8, Height: 8, Fit: "none", Gamma: 1, Dither: "none"})
+	for _, b := range p.Pix {
+		if b != p.Pix[0] {
+			t.Fatal("Expected a flat result without dithering")
+		}
+	}
+}
+
+func TestGammaAndInvert(t *testing.T) {
+	g := solid(1, 1, 128)
+	applyTone(g, 2.0, false)
+	if g.Pix[0] >= 128 {
+		t.Errorf("Expected gamma 2 to darken midtones, got %d", g.Pix[0])
+	}
+	g = solid(1, 1, 0)
+	applyTone(g, 1.0, true)
+	if g.Pix[0] != 255 {
+		t.Errorf("Expected inverted black to be white, got %d", g.Pix[0])
+	}
+}
+
+func TestCrop(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 4, 4))
+	img.SetGray(3, 3, color.Gray{Y: 255})
+	r, err := parseCrop("2,2,2,2")
+	if err != nil {
+		t.Fatalf("parseCrop failed: %v", err)
+	}
+	p, err := convert(img, options{BPP: 4, Width: 2, Height: 2, Fit: "none", Gamma: 1, Dither: "none", Crop: &r})
+	if err != nil {
+		t.Fatalf("convert failed: %v", err)
+	}
+	if p.Pix[0] != 0xFF || p.Pix[1] != 0xF0 {
+		t.Errorf("Unexpected cropped pixels % x", p.Pix)
+	}
+
+	for _, bad := range []string{"1,2,3", "a,b,c,d", "0,0,0,5"} {
+		if _, err := parseCrop(bad); err == nil {
+			t.Errorf("Expected error for crop %q", bad)
+		}
+	}
+}
+
+func TestGoSourceAndPreview(t *testing.T) {
+	p := &packedImage{BPP: 4, Width: 2, Height: 1, Pix: []byte{0x0F}}
+	src, err := goSource(p, "Logo", "assets", "logo.png")
+	if err != nil {
+		t.Fatalf("goSource failed: %v", err)
+	}
+	s := string(src)
+	for _, want := range []string{"package assets", "LogoWidth  = 2", "LogoHeight = 1", "var Logo = []byte{", "0x0f,"} {
+		if !strings.Contains(s, want) {
+			t.Errorf("Generated source missing %q:\n%s", want, s)
+		}
+	}
+
+	g := previewImage(p)
+	if g.GrayAt(0, 0).Y != 255 || g.GrayAt(1, 0).Y != 0 {
+		t.Errorf("Unexpected preview pixels %d %d", g.GrayAt(0, 0).Y, g.GrayAt(1, 0).Y)
+	}
+}
+
+func TestCalibration(t *testing.T) {
+	c, err := loadCalibration("250,245,240,235,228,220,205,190,170,150,125,100,75,50,25,10")
+	if err != nil {
+		t.Fatalf("loadCalibration failed: %v", err)
+	}
+	// Gray 235 is level 3 on this panel but level 1 when evenly spaced.
+	p, err := convert(solid(2, 1, 235), options{BPP: 4, Width: 2, Height: 1, Fit: "none", Gamma: 1, Dither: "none", Calibration: c})
+	if err != nil {
+		t.Fatalf("convert failed: %v", err)
+	}
+	if p.Pix[0] != 0x33 {
+		t.Errorf("Expected calibrated level 3, got % x", p.Pix)
+	}
+
+	path := filepath.Join(t.TempDir(), "panel.cal")
+	b, _ := c.MarshalBinary()
+	if err := os.WriteFile(path, b, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := loadCalibration(path); err != nil || *got != *c {
+		t.Errorf("Expected blob to load, got %v %v", got, err)
+	}
+
+	for _, bad := range []string{"1,2,3", "10,20,30,40,50,60,70,80,90,100,110,120,130,140,150,160", "missing.cal"} {
+		if _, err := loadCalibration(bad); err == nil {
+			t.Errorf("Expected error for calibration %q", bad)
+		}
+	}
+}
+
+func TestAssetOutput(t *testing.T) {
+	p, err := convert(solid(40, 20, 128), options{BPP: 4, Width: 40, Height: 20, Fit: "none", Gamma: 1, Dither: "bayer4"})
+	if err != nil {
+		t.Fatalf("convert failed: %v", err)
+	}
+	blob, err := encodeAsset(p)
+	if err != nil {
+		t.Fatalf("encodeAsset failed: %v", err)
+	}
+	if len(blob) >= len(p.Pix) {
+		t.Errorf("Expected a flat dither to compress, got %d of %d bytes", len(blob), len(p.Pix))
+	}
+
+	// The asset decodes back to the converted pixels.
+	a, err := asset.Open(string(blob))
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	r := a.Rows4bpp()
+	row := make([]byte, r.Stride())
+	for y := 0; y < p.Height; y++ {
+		if err := r.ReadRow(y, row); err != nil {
+			t.Fatalf("ReadRow failed: %v", err)
+		}
+		if string(row) != string(p.Pix[y*20:(y+1)*20]) {
+			t.Fatalf("Row %d differs after the round trip", y)
+		}
+	}
+
+	src, err := assetSource(blob, p, "Splash", "assets", "splash.png")
+	if err != nil {
+		t.Fatalf("assetSource failed: %v", err)
+	}
+	for _, want := range []string{"package assets", "SplashWidth  = 40", "const Splash = \"\" +", `"\x45\x50\x44\x49`} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("Generated source missing %q:\n%s", want, src)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Command epdconvert converts PNG, JPEG and GIF images into packed 1bpp or
+// 4bpp assets for the epd47 driver.
+//
+// The image is optionally cropped, fitted to the panel (or a target box),
+// gamma-adjusted and dithered, then written either as a Go source file
+// holding a byte slice or as raw packed bytes. With -asset the output is a
+// compressed asset (see package asset) instead. A preview PNG shows what the
+// panel will display.
+//
+// Usage:
+//
+//	epdconvert -in photo.jpg -bpp 4 -fit cover -out photo.go -name Photo -pkg main -preview photo_preview.png
+//	epdconvert -in logo.png -bpp 1 -width 200 -height 80 -dither fs -out logo.bin
+//	epdconvert -in splash.png -bpp 4 -asset -out splash.go -name Splash
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+)
+
+func main() {
+	var (
+		in      = flag.String("in", "", "input image (.png, .jpg, .gif)")
+		out     = flag.String("out", "", "output file (.go for source, anything else for raw bytes)")
+		format  = flag.String("format", "", "output format: go or raw (default from -out extension)")
+		preview = flag.String("preview", "", "write a PNG preview of the panel output")
+		name    = flag.String("name", "Image", "Go identifier for -format go")
+		pkg     = flag.String("pkg", "main", "package for -format go")
+		bpp     = flag.Int("bpp", 4, "output depth: 1 or 4")
+		width   = flag.Int("width", epd47.MaxWidth, "target width in pixels")
+		height  = flag.Int("height", epd47.MaxHeight, "target height in pixels")
+		fit     = flag.String("fit", "contain", "fit mode: contain, cover, stretch or none")
+		crop    = flag.String("crop", "", "crop the source first: x,y,w,h")
+		gamma   = flag.Float64("gamma", 1.0, "gamma applied to the gray values (>1 darkens midtones)")
+		dither  = flag.String("dither", "fs", "dithering: none, fs (floyd-steinberg), atkinson, bayer4, bayer8 or blue-noise")
+		invert  = flag.Bool("invert", false, "invert the image")
+		calib   = flag.String("calibration", "", "panel calibration: a blob file or 16 comma-separated grays")
+		pack    = flag.Bool("asset", false, "write a compressed EPDI asset (default for .epi output)")
+	)
+	flag.Parse()
+
+	if *in == "" || (*out == "" && *preview == "") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	opts := options{
+		BPP:    *bpp,
+		Width:  *width,
+		Height: *height,
+		Fit:    *fit,
+		Gamma:  *gamma,
+		Dither: *dither,
+		Invert: *invert,
+	}
+	if *crop != "" {
+		r, err := parseCrop(*crop)
+		if err != nil {
+			fatal(err)
+		}
+		opts.Crop = &r
+	}
+	if *calib != "" {
+		c, err := loadCalibration(*calib)
+		if err != nil {
+			fatal(err)
+		}
+		opts.Calibration = c
+	}
+
+	img, err := loadImage(*in)
+	if err != nil {
+		fatal(err)
+	}
+	a, err := convert(img, opts)
+	if err != nil {
+		fatal(err)
+	}
+
+	if *out != "" {
+		f := *format
+		if f == "" {
+			f = "raw"
+			if strings.EqualFold(filepath.Ext(*out), ".go") {
+				f = "go"
+			}
+		}
+		if strings.EqualFold(filepath.Ext(*out), ".epi") {
+			*pack = true
+		}
+		var blob []byte
+		if *pack {
+			if blob, err = encodeAsset(a); err != nil {
+				fatal(err)
+			}
+		}
+		var data []byte
+		switch {
+		case f == "go" && *pack:
+			data, err = assetSource(blob, a, *name, *pkg, filepath.Base(*in))
+		case f == "go":
+			data, err = goSource(a, *name, *pkg, filepath.Base(*in))
+		case f == "raw" && *pack:
+			data = blob
+		case f == "raw":
+			data = a.Pix
+		default:
+			err = fmt.Errorf("unknown format %q", f)
+		}
+		if err != nil {
+			fatal(err)
+		}
+		if err := os.WriteFile(*out, data, 0o644); err != nil {
+			fatal(err)
+		}
+	}
+
+	if *preview != "" {
+		if err := writePreview(*preview, a); err != nil {
+			fatal(err)
+		}
+	}
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "epdconvert:", err)
+	os.Exit(1)
+}
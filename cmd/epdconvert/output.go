@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"image/png"
+	"os"
+
+	"github.com/abaschen/tinygo-epd47-s3/asset"
+)
+
+// goSource renders p as a Go file declaring <name>Width, <name>Height and
+// the packed <name> byte slice, ready for Draw1bpp or DrawImage4bpp.
+func goSource(p *packedImage, name, pkg, source string) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by epdconvert from %s; DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "const (\n%sWidth = %d\n%sHeight = %d\n)\n\n", name, p.Width, name, p.Height)
+	fmt.Fprintf(&b, "// %s is a %dx%d %dbpp image packed for the epd47 driver.\n", name, p.Width, p.Height, p.BPP)
+	fmt.Fprintf(&b, "var %s = []byte{\n", name)
+	for i := 0; i < len(p.Pix); i += 16 {
+		end := min(i+16, len(p.Pix))
+		for _, v := range p.Pix[i:end] {
+			fmt.Fprintf(&b, "0x%02x, ", v)
+		}
+		b.WriteByte('\n')
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
+
+// encodeAsset compresses p into an EPDI asset, reducing the depth when the
+// image uses few levels.
+func encodeAsset(p *packedImage) ([]byte, error) {
+	return asset.Encode(asset.FromPacked(p.BPP, p.Width, p.Height, p.Pix), asset.PackBits)
+}
+
+// assetSource renders an encoded asset as a Go string constant, which
+// TinyGo keeps in flash, for asset.Open.
+func assetSource(blob []byte, p *packedImage, name, pkg, source string) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by epdconvert from %s; DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "const (\n%sWidth = %d\n%sHeight = %d\n)\n\n", name, p.Width, name, p.Height)
+	fmt.Fprintf(&b, "// %s is a %dx%d %dbpp image as a compressed asset (%d bytes, %d unpacked).\n", name, p.Width, p.Height, p.BPP, len(blob), len(p.Pix))
+	fmt.Fprintf(&b, "const %s = \"\" +\n", name)
+	for i := 0; i < len(blob); i += 32 {
+		end := min(i+32, len(blob))
+		b.WriteByte('"')
+		for _, v := range blob[i:end] {
+			fmt.Fprintf(&b, "\\x%02x", v)
+		}
+		b.WriteByte('"')
+		if end < len(blob) {
+			b.WriteString(" +")
+		}
+		b.WriteByte('\n')
+	}
+	return format.Source(b.Bytes())
+}
+
+func writePreview(path string, p *packedImage) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, previewImage(p)); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
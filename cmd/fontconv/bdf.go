@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// loadBDF parses a Glyph Bitmap Distribution Format font. BDF glyphs are
+// 1-bit, so set pixels become fully opaque coverage.
+func loadBDF(data []byte, rs []runeRange) (*fontData, error) {
+	fd := &fontData{}
+	var (
+		bbxH, bbxY int // FONTBOUNDINGBOX height and y offset
+		cur        *glyph
+		encoding   int
+		inBitmap   bool
+		row        int
+	)
+
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	line := 0
+	for sc.Scan() {
+		line++
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if inBitmap {
+			if fields[0] == "ENDCHAR" {
+				inBitmap = false
+				if cur != nil && encoding >= 0 && inRanges(rs, rune(encoding)) {
+					cur.trim()
+					fd.glyphs = append(fd.glyphs, *cur)
+				}
+				cur = nil
+				continue
+			}
+			if row >= cur.h {
+				return nil, fmt.Errorf("line %d: too many bitmap rows", line)
+			}
+			bits, err := hex.DecodeString(fields[0])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", line, err)
+			}
+			for x := 0; x < cur.w && x/8 < len(bits); x++ {
+				if bits[x/8]&(0x80>>uint(x%8)) != 0 {
+					cur.cov[row*cur.w+x] = 15
+				}
+			}
+			row++
+			continue
+		}
+
+		nums, err := atoiAll(fields[1:])
+		switch fields[0] {
+		case "FONT":
+			fd.name = strings.Join(fields[1:], " ")
+		case "FONTBOUNDINGBOX":
+			if err != nil || len(nums) < 4 {
+				return nil, fmt.Errorf("line %d: bad FONTBOUNDINGBOX", line)
+			}
+			bbxH, bbxY = nums[1], nums[3]
+		case "FONT_ASCENT":
+			if err == nil && len(nums) > 0 {
+				fd.ascent = nums[0]
+			}
+		case "FONT_DESCENT":
+			if err == nil && len(nums) > 0 {
+				fd.descent = nums[0]
+			}
+		case "STARTCHAR":
+			cur = &glyph{}
+			encoding = -1
+		case "ENCODING":
+			if cur == nil || err != nil || len(nums) < 1 {
+				return nil, fmt.Errorf("line %d: bad ENCODING", line)
+			}
+			encoding = nums[0]
+			cur.r = rune(encoding)
+		case "DWIDTH":
+			if cur == nil || err != nil || len(nums) < 1 {
+				return nil, fmt.Errorf("line %d: bad DWIDTH", line)
+			}
+			cur.advance = nums[0]
+		case "BBX":
+			if cur == nil || err != nil || len(nums) < 4 || nums[0] < 0 || nums[1] < 0 {
+				return nil, fmt.Errorf("line %d: bad BBX", line)
+			}
+			cur.w, cur.h = nums[0], nums[1]
+			cur.left = nums[2]
+			cur.top = nums[3] + nums[1]
+		case "BITMAP":
+			if cur == nil {
+				return nil, fmt.Errorf("line %d: BITMAP outside STARTCHAR", line)
+			}
+			cur.cov = make([]uint8, cur.w*cur.h)
+			inBitmap = true
+			row = 0
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	if fd.ascent == 0 && fd.descent == 0 {
+		fd.ascent, fd.descent = bbxH+bbxY, -bbxY
+	}
+	fd.lineHeight = fd.ascent + fd.descent
+	sortGlyphs(fd.glyphs)
+	return fd, nil
+}
+
+func atoiAll(fields []string) ([]int, error) {
+	nums := make([]int, len(fields))
+	for i, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil {
+			return nil, err
+		}
+		nums[i] = n
+	}
+	return nums, nil
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+const testBDF = `STARTFONT 2.1
+FONT -test-tiny
+SIZE 8 75 75
+FONTBOUNDINGBOX 4 6 0 -1
+STARTPROPERTIES 2
+FONT_ASCENT 5
+FONT_DESCENT 1
+ENDPROPERTIES
+CHARS 2
+STARTCHAR A
+ENCODING 65
+SWIDTH 500 0
+DWIDTH 5 0
+BBX 4 5 0 0
+BITMAP
+60
+90
+F0
+90
+90
+ENDCHAR
+STARTCHAR uni2022
+ENCODING 8226
+SWIDTH 500 0
+DWIDTH 5 0
+BBX 4 6 0 -1
+BITMAP
+00
+00
+60
+60
+00
+00
+ENDCHAR
+ENDFONT
+`
+
+func TestParseRanges(t *testing.T) {
+	rs, err := parseRanges("32-126, 0xB0,0x2000-0x206F")
+	if err != nil {
+		t.Fatalf("parseRanges failed: %v", err)
+	}
+	if len(rs) != 3 || rs[1] != (runeRange{0xB0, 0xB0}) {
+		t.Errorf("Unexpected ranges %v", rs)
+	}
+	if !inRanges(rs, 'A') || inRanges(rs, 0x7F) || !inRanges(rs, 0x2022) {
+		t.Error("inRanges mismatch")
+	}
+
+	for _, bad := range []string{"", "x", "10-5", "1-y"} {
+		if _, err := parseRanges(bad); err == nil {
+			t.Errorf("Expected error for %q", bad)
+		}
+	}
+}
+
+func TestLoadBDF(t *testing.T) {
+	rs, _ := parseRanges("0-0x10FFFF")
+	fd, err := loadBDF([]byte(testBDF), rs)
+	if err != nil {
+		t.Fatalf("loadBDF failed: %v", err)
+	}
+	if fd.ascent != 5 || fd.descent != 1 || fd.lineHeight != 6 {
+		t.Errorf("Unexpected metrics %d/%d/%d", fd.ascent, fd.descent, fd.lineHeight)
+	}
+	if len(fd.glyphs) != 2 {
+		t.Fatalf("Expected 2 glyphs, got %d", len(fd.glyphs))
+	}
+
+	a := fd.glyphs[0]
+	if a.r != 'A' || a.w != 4 || a.h != 5 || a.top != 5 || a.advance != 5 {
+		t.Errorf("Unexpected glyph A: %+v", a)
+	}
+	if a.cov[0] != 0 || a.cov[1] != 15 || a.cov[2*4+3] != 15 {
+		t.Error("Unexpected coverage for A")
+	}
+
+	// The bullet is trimmed to its 2x2 dot, 3px above the baseline.
+	b := fd.glyphs[1]
+	if b.r != 0x2022 || b.w != 2 || b.h != 2 || b.left != 1 || b.top != 3 {
+		t.Errorf("Unexpected trimmed bullet: %+v", b)
+	}
+
+	// Range selection drops the bullet.
+	rs, _ = parseRanges("32-126")
+	fd, err = loadBDF([]byte(testBDF), rs)
+	if err != nil || len(fd.glyphs) != 1 {
+		t.Errorf("Expected only A in ASCII range, got %d glyphs (%v)", len(fd.glyphs), err)
+	}
+
+	bad := strings.Replace(testBDF, "BBX 4 5 0 0", "BBX -4 5 0 0", 1)
+	if _, err := loadBDF([]byte(bad), rs); err == nil || !strings.Contains(err.Error(), "bad BBX") {
+		t.Errorf("Expected a bad BBX error for a negative width, got %v", err)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	rs, _ := parseRanges("32-126")
+	fd, err := loadBDF([]byte(testBDF), rs)
+	if err != nil {
+		t.Fatalf("loadBDF failed: %v", err)
+	}
+	fd.fallback = 'A'
+
+	src, err := generate(fd, genOptions{Name: "Tiny", Package: "fonts", Source: "tiny.bdf", Compressed: true})
+	if err != nil {
+		t.Fatalf("generate failed: %v", err)
+	}
+	s := string(src)
+	for _, want := range []string{
+		"package fonts",
+		`import "github.com/abaschen/tinygo-epd47-s3/font"`,
+		"var Tiny = &font.Font{",
+		"Compressed: true,",
+		"{Rune: 'A', Offset: 0, Width: 4, Height: 5, Advance: 5, Left: 0, Top: 5},",
+	} {
+		if !strings.Contains(s, want) {
+			t.Errorf("Generated source missing %q:\n%s", want, s)
+		}
+	}
+
+	src, err = generate(fd, genOptions{Name: "Tiny", Package: "font", Source: "tiny.bdf"})
+	if err != nil {
+		t.Fatalf("generate failed: %v", err)
+	}
+	if s := string(src); strings.Contains(s, "font.Font") || strings.Contains(s, "Compressed") {
+		t.Errorf("Expected unqualified, uncompressed output in package font:\n%s", s)
+	}
+}
+
+func TestLoadOutline(t *testing.T) {
+	data, err := os.ReadFile("/usr/share/fonts/truetype/dejavu/DejaVuSans.ttf")
+	if err != nil {
+		t.Skip("DejaVuSans.ttf not installed")
+	}
+	rs, _ := parseRanges("65-90")
+	fd, err := loadOutline(data, 16, rs, true)
+	if err != nil {
+		t.Fatalf("loadOutline failed: %v", err)
+	}
+	if len(fd.glyphs) != 26 {
+		t.Errorf("Expected 26 glyphs, got %d", len(fd.glyphs))
+	}
+	if fd.ascent <= 0 || fd.lineHeight < fd.ascent {
+		t.Errorf("Unexpected metrics %d/%d/%d", fd.ascent, fd.descent, fd.lineHeight)
+	}
+	if len(fd.kerns) == 0 {
+		t.Error("Expected kerning pairs")
+	}
+
+	// Pairs are only looked up among Latin code points.
+	rs, _ = parseRanges("65-90,0x391-0x3A9")
+	if fd, err = loadOutline(data, 16, rs, true); err != nil {
+		t.Fatalf("loadOutline failed: %v", err)
+	}
+	for _, k := range fd.kerns {
+		if k.Left > maxKernRune || k.Right > maxKernRune {
+			t.Errorf("Unexpected kerning pair %U/%U", k.Left, k.Right)
+		}
+	}
+}
+
+func TestKernSaturates(t *testing.T) {
+	data, err := os.ReadFile("/usr/share/fonts/truetype/dejavu/DejaVuSans.ttf")
+	if err != nil {
+		t.Skip("DejaVuSans.ttf not installed")
+	}
+	// At this size the A/V pair moves well past the int8 range.
+	rs, _ := parseRanges("65,86")
+	fd, err := loadOutline(data, 3000, rs, true)
+	if err != nil {
+		t.Fatalf("loadOutline failed: %v", err)
+	}
+	for _, k := range fd.kerns {
+		if k.Left == 'A' && k.Right == 'V' && k.Adjust != -128 {
+			t.Errorf("Expected A/V kerning saturated at -128, got %d", k.Adjust)
+		}
+	}
+	if len(fd.kerns) == 0 {
+		t.Error("Expected kerning pairs")
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strconv"
+	"strings"
+
+	"github.com/abaschen/tinygo-epd47-s3/font"
+)
+
+type genOptions struct {
+	Name       string // exported identifier of the font
+	Package    string
+	Source     string // input file name, for the header
+	Compressed bool
+	Args       []string // command line, for the header
+}
+
+// generate renders fd as a gofmt'ed Go source file.
+func generate(fd *fontData, opt genOptions) ([]byte, error) {
+	// Inside package font the types are unqualified and literals can stay
+	// positional; elsewhere vet wants keyed fields.
+	q := ""
+	if opt.Package != "font" {
+		q = "font."
+	}
+	lower := strings.ToLower(opt.Name[:1]) + opt.Name[1:]
+
+	var bm []byte
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by fontconv %s; DO NOT EDIT.\n\n", strings.Join(opt.Args, " "))
+	fmt.Fprintf(&b, "package %s\n\n", opt.Package)
+	if q != "" {
+		b.WriteString("import \"github.com/abaschen/tinygo-epd47-s3/font\"\n\n")
+	}
+
+	name := fd.name
+	if name == "" {
+		name = opt.Source
+	}
+	fmt.Fprintf(&b, "// %s is %s converted from %s.\n", opt.Name, name, opt.Source)
+	fmt.Fprintf(&b, "var %s = &%sFont{\n", opt.Name, q)
+	fmt.Fprintf(&b, "Name: %q,\n", name)
+	fmt.Fprintf(&b, "Ascent: %d,\nDescent: %d,\nLineHeight: %d,\n", fd.ascent, fd.descent, fd.lineHeight)
+	fmt.Fprintf(&b, "Glyphs: %sGlyphs[:],\n", lower)
+	if len(fd.kerns) > 0 {
+		fmt.Fprintf(&b, "Kerning: %sKerning[:],\n", lower)
+	}
+	fmt.Fprintf(&b, "Bitmap: %sBitmap,\n", lower)
+	if opt.Compressed {
+		b.WriteString("Compressed: true,\n")
+	}
+	if fd.fallback != 0 {
+		fmt.Fprintf(&b, "Fallback: %s,\n", quoteRune(fd.fallback))
+	}
+	b.WriteString("}\n\n")
+
+	fmt.Fprintf(&b, "var %sGlyphs = [...]%sGlyph{\n", lower, q)
+	for _, g := range fd.glyphs {
+		if g.w > 255 || g.h > 255 || g.advance < 0 || g.advance > 255 ||
+			g.left < -128 || g.left > 127 || g.top < -128 || g.top > 127 {
+			return nil, fmt.Errorf("glyph %q does not fit the font metrics format", g.r)
+		}
+		off := len(bm)
+		if opt.Compressed {
+			bm = font.AppendRLE(bm, g.cov)
+		} else {
+			bm = appendPacked(bm, g)
+		}
+		if q == "" {
+			fmt.Fprintf(&b, "{%s, %d, %d, %d, %d, %d, %d},\n", quoteRune(g.r), off, g.w, g.h, g.advance, g.left, g.top)
+		} else {
+			fmt.Fprintf(&b, "{Rune: %s, Offset: %d, Width: %d, Height: %d, Advance: %d, Left: %d, Top: %d},\n",
+				quoteRune(g.r), off, g.w, g.h, g.advance, g.left, g.top)
+		}
+	}
+	b.WriteString("}\n\n")
+
+	if len(fd.kerns) > 0 {
+		fmt.Fprintf(&b, "var %sKerning = [...]%sKern{\n", lower, q)
+		for i, k := range fd.kerns {
+			if q == "" {
+				fmt.Fprintf(&b, "{%s, %s, %d},", quoteRune(k.Left), quoteRune(k.Right), k.Adjust)
+			} else {
+				fmt.Fprintf(&b, "{Left: %s, Right: %s, Adjust: %d},", quoteRune(k.Left), quoteRune(k.Right), k.Adjust)
+			}
+			if i%4 == 3 || i == len(fd.kerns)-1 {
+				b.WriteByte('\n')
+			} else {
+				b.WriteByte(' ')
+			}
+		}
+		b.WriteString("}\n\n")
+	}
+
+	fmt.Fprintf(&b, "// %d bytes\nconst %sBitmap = \"\" +\n", len(bm), lower)
+	for i := 0; i < len(bm); i += 32 {
+		end := min(i+32, len(bm))
+		b.WriteByte('"')
+		for _, v := range bm[i:end] {
+			fmt.Fprintf(&b, "\\x%02x", v)
+		}
+		b.WriteByte('"')
+		if end < len(bm) {
+			b.WriteString(" +")
+		}
+		b.WriteByte('\n')
+	}
+	if len(bm) == 0 {
+		b.WriteString("\"\"\n")
+	}
+
+	return format.Source(b.Bytes())
+}
+
+// appendPacked appends g's coverage as 4bpp rows, high nibble first,
+// each row padded to a whole byte.
+func appendPacked(dst []byte, g glyph) []byte {
+	stride := (g.w + 1) / 2
+	for y := 0; y < g.h; y++ {
+		row := make([]byte, stride)
+		for x := 0; x < g.w; x++ {
+			v := g.cov[y*g.w+x] & 0x0F
+			if x&1 == 0 {
+				row[x>>1] |= v << 4
+			} else {
+				row[x>>1] |= v
+			}
+		}
+		dst = append(dst, row...)
+	}
+	return dst
+}
+
+// quoteRune returns a Go rune literal, using escapes for non-printable runes.
+func quoteRune(r rune) string {
+	return strconv.QuoteRuneToASCII(r)
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/abaschen/tinygo-epd47-s3/font"
+)
+
+// glyph is a rasterized character before it is packed into Go source.
+type glyph struct {
+	r         rune
+	w, h      int
+	left, top int // bitmap offset from the pen position, top measured up from the baseline
+	advance   int
+	cov       []uint8 // w*h coverage values, 0-15
+}
+
+// fontData is a converted font ready for code generation.
+type fontData struct {
+	name                        string
+	ascent, descent, lineHeight int
+	glyphs                      []glyph // sorted by rune
+	kerns                       []font.Kern
+	fallback                    rune
+}
+
+// runeRange is an inclusive code point range.
+type runeRange struct{ lo, hi rune }
+
+// parseRanges parses "32-126,0xA0-0xFF,0x2022" into ranges.
+func parseRanges(s string) ([]runeRange, error) {
+	var rs []runeRange
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		lo, hi, isRange := strings.Cut(part, "-")
+		a, err := strconv.ParseInt(strings.TrimSpace(lo), 0, 32)
+		if err != nil {
+			return nil, fmt.Errorf("bad range %q: %v", part, err)
+		}
+		b := a
+		if isRange {
+			if b, err = strconv.ParseInt(strings.TrimSpace(hi), 0, 32); err != nil {
+				return nil, fmt.Errorf("bad range %q: %v", part, err)
+			}
+		}
+		if b < a {
+			return nil, fmt.Errorf("bad range %q: end before start", part)
+		}
+		rs = append(rs, runeRange{rune(a), rune(b)})
+	}
+	if len(rs) == 0 {
+		return nil, fmt.Errorf("empty code point selection")
+	}
+	return rs, nil
+}
+
+func inRanges(rs []runeRange, r rune) bool {
+	for _, rr := range rs {
+		if r >= rr.lo && r <= rr.hi {
+			return true
+		}
+	}
+	return false
+}
+
+// trim shrinks a glyph to the bounding box of its non-zero coverage.
+func (g *glyph) trim() {
+	minX, minY, maxX, maxY := g.w, g.h, 0, 0
+	for y := 0; y < g.h; y++ {
+		for x := 0; x < g.w; x++ {
+			if g.cov[y*g.w+x] != 0 {
+				minX, minY = min(minX, x), min(minY, y)
+				maxX, maxY = max(maxX, x+1), max(maxY, y+1)
+			}
+		}
+	}
+	if maxX <= minX {
+		g.w, g.h, g.left, g.top, g.cov = 0, 0, 0, 0, nil
+		return
+	}
+	cov := make([]uint8, 0, (maxX-minX)*(maxY-minY))
+	for y := minY; y < maxY; y++ {
+		cov = append(cov, g.cov[y*g.w+minX:y*g.w+maxX]...)
+	}
+	g.left += minX
+	g.top -= minY
+	g.w, g.h, g.cov = maxX-minX, maxY-minY, cov
+}
+
+func sortGlyphs(gs []glyph) {
+	sort.Slice(gs, func(i, j int) bool { return gs[i].r < gs[j].r })
+}
+
+func sortKerns(ks []font.Kern) {
+	sort.Slice(ks, func(i, j int) bool {
+		if ks[i].Left != ks[j].Left {
+			return ks[i].Left < ks[j].Left
+		}
+		return ks[i].Right < ks[j].Right
+	})
+}
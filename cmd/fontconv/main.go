@@ -0,0 +1,111 @@
+// Command fontconv converts BDF and TrueType/OpenType fonts into Go source
+// for the font package.
+//
+// Glyphs are rasterized at the requested pixel size, quantized to 4bpp
+// coverage and run-length compressed. Only the selected code point ranges are
+// emitted, which keeps flash usage down on device.
+//
+// Usage:
+//
+//	fontconv -in DejaVuSans.ttf -size 16 -name DejaVuSans16 -out dejavu_sans16.go
+//	fontconv -in spleen-8x16.bdf -name Spleen16 -ranges 32-126,0xB0 -pkg main
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	var (
+		in       = flag.String("in", "", "input font (.ttf, .otf or .bdf)")
+		out      = flag.String("out", "", "output Go file (default stdout)")
+		size     = flag.Float64("size", 16, "pixel size for outline fonts")
+		name     = flag.String("name", "", "Go identifier of the generated font")
+		pkg      = flag.String("pkg", "font", "package of the generated file")
+		ranges   = flag.String("ranges", "32-126", "code points to include, e.g. 32-126,0xA0-0xFF")
+		fallback = flag.String("fallback", "?", "glyph drawn for missing code points (empty for none)")
+		kern     = flag.Bool("kern", true, "include the kerning table (pairs up to U+024F)")
+		raw      = flag.Bool("raw", false, "emit uncompressed packed bitmaps")
+	)
+	flag.Parse()
+
+	if *in == "" || *name == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(*in, *out, *size, *name, *pkg, *ranges, *fallback, *kern, *raw); err != nil {
+		fmt.Fprintln(os.Stderr, "fontconv:", err)
+		os.Exit(1)
+	}
+}
+
+func run(in, out string, size float64, name, pkg, ranges, fallback string, kern, raw bool) error {
+	rs, err := parseRanges(ranges)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(in)
+	if err != nil {
+		return err
+	}
+
+	var fd *fontData
+	switch strings.ToLower(filepath.Ext(in)) {
+	case ".bdf":
+		fd, err = loadBDF(data, rs)
+	case ".ttf", ".otf":
+		fd, err = loadOutline(data, size, rs, kern)
+	default:
+		err = fmt.Errorf("unsupported font format %q", filepath.Ext(in))
+	}
+	if err != nil {
+		return err
+	}
+	if len(fd.glyphs) == 0 {
+		return fmt.Errorf("no glyphs in range %s", ranges)
+	}
+	if !kern {
+		fd.kerns = nil
+	}
+	if fallback != "" {
+		fd.fallback = []rune(fallback)[0]
+	}
+
+	src, err := generate(fd, genOptions{
+		Name:       name,
+		Package:    pkg,
+		Source:     filepath.Base(in),
+		Compressed: !raw,
+		Args:       headerArgs(os.Args[1:]),
+	})
+	if err != nil {
+		return err
+	}
+	if out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(out, src, 0o644)
+}
+
+// headerArgs returns the command line for the generated file header with the
+// input path reduced to its base name, so output does not depend on where the
+// font was installed.
+func headerArgs(args []string) []string {
+	out := make([]string, len(args))
+	for i, a := range args {
+		out[i] = a
+		if i > 0 && (args[i-1] == "-in" || args[i-1] == "--in") {
+			out[i] = filepath.Base(a)
+		}
+		if v, ok := strings.CutPrefix(a, "-in="); ok {
+			out[i] = "-in=" + filepath.Base(v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+
+	"github.com/abaschen/tinygo-epd47-s3/font"
+	xfont "golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// maxKernRune bounds the code points kerned: the end of Latin Extended-B.
+// Every pair is looked up, so the cost is quadratic in the runes kerned.
+const maxKernRune = 0x24F
+
+// loadOutline rasterizes a TrueType/OpenType font at size pixels.
+// Kerning comes from the font's 'kern' table when present, for pairs of
+// code points up to maxKernRune.
+func loadOutline(data []byte, size float64, rs []runeRange, kern bool) (*fontData, error) {
+	f, err := opentype.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{
+		Size:    size,
+		DPI:     72, // 1pt == 1px
+		Hinting: xfont.HintingFull,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer face.Close()
+
+	m := face.Metrics()
+	fd := &fontData{
+		ascent:     m.Ascent.Ceil(),
+		descent:    m.Descent.Ceil(),
+		lineHeight: m.Height.Ceil(),
+	}
+	var buf sfnt.Buffer
+	if name, err := f.Name(&buf, sfnt.NameIDFull); err == nil {
+		fd.name = fmt.Sprintf("%s %gpx", name, size)
+	}
+
+	var runes []rune // kerned code points
+	for _, rr := range rs {
+		for r := rr.lo; r <= rr.hi; r++ {
+			if idx, err := f.GlyphIndex(&buf, r); err != nil || idx == 0 {
+				continue
+			}
+			dr, mask, mp, adv, ok := face.Glyph(fixed.P(0, 0), r)
+			if !ok {
+				continue
+			}
+			g := glyph{
+				r:       r,
+				w:       dr.Dx(),
+				h:       dr.Dy(),
+				left:    dr.Min.X,
+				top:     -dr.Min.Y,
+				advance: adv.Round(),
+			}
+			a := image.NewAlpha(image.Rect(0, 0, g.w, g.h))
+			draw.Draw(a, a.Bounds(), mask, mp, draw.Src)
+			g.cov = make([]uint8, g.w*g.h)
+			for i, v := range a.Pix {
+				g.cov[i] = uint8((int(v)*15 + 127) / 255)
+			}
+			g.trim()
+			fd.glyphs = append(fd.glyphs, g)
+			if r <= maxKernRune {
+				runes = append(runes, r)
+			}
+		}
+	}
+	sortGlyphs(fd.glyphs)
+
+	if kern {
+		ppem := fixed.Int26_6(size * 64)
+		for _, l := range runes {
+			li, _ := f.GlyphIndex(&buf, l)
+			for _, r := range runes {
+				ri, _ := f.GlyphIndex(&buf, r)
+				k, err := f.Kern(&buf, li, ri, ppem, xfont.HintingNone)
+				if err != nil || k.Round() == 0 {
+					continue
+				}
+				// Adjust is an int8; saturate rather than wrap at large sizes.
+				adj := max(-128, min(k.Round(), 127))
+				fd.kerns = append(fd.kerns, font.Kern{Left: l, Right: r, Adjust: int8(adj)})
+			}
+		}
+		sortKerns(fd.kerns)
+	}
+	return fd, nil
+}
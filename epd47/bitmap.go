@@ -0,0 +1,117 @@
+package epd47
+
+// Bitmap1bpp is an off-screen image in the packed MSB-first 1bpp layout
+// Draw1bpp consumes. A set bit is ink (black).
+type Bitmap1bpp struct {
+	Width, Height int
+	Stride        int // bytes per row
+	Pix           []byte
+}
+
+// Bitmap4bpp is an off-screen image in the packed high-nibble-first 4bpp
+// layout DrawImage4bpp consumes. Levels are ink densities: 0 is paper white
+// and 15 full black, matching SetGrayscalePixel where 0 means nothing drawn.
+type Bitmap4bpp struct {
+	Width, Height int
+	Stride        int // bytes per row
+	Pix           []byte
+}
+
+// NewBitmap1bpp allocates a blank (all white) w x h 1bpp bitmap.
+func NewBitmap1bpp(w, h int) *Bitmap1bpp {
+	if w < 0 {
+		w = 0
+	}
+	if h < 0 {
+		h = 0
+	}
+	stride := (w + 7) / 8
+	return &Bitmap1bpp{Width: w, Height: h, Stride: stride, Pix: make([]byte, stride*h)}
+}
+
+// NewBitmap4bpp allocates a blank (all white) w x h 4bpp bitmap.
+func NewBitmap4bpp(w, h int) *Bitmap4bpp {
+	if w < 0 {
+		w = 0
+	}
+	if h < 0 {
+		h = 0
+	}
+	stride := (w + 1) / 2
+	return &Bitmap4bpp{Width: w, Height: h, Stride: stride, Pix: make([]byte, stride*h)}
+}
+
+// Get reports whether the pixel at x,y is inked. Out-of-range reads return false.
+func (b *Bitmap1bpp) Get(x, y int) bool {
+	if x < 0 || y < 0 || x >= b.Width || y >= b.Height {
+		return false
+	}
+	return b.Pix[y*b.Stride+x>>3]&(0x80>>uint(x&7)) != 0
+}
+
+// Set inks (true) or clears (false) the pixel at x,y. Out-of-range writes are ignored.
+func (b *Bitmap1bpp) Set(x, y int, on bool) {
+	if x < 0 || y < 0 || x >= b.Width || y >= b.Height {
+		return
+	}
+	i := y*b.Stride + x>>3
+	if on {
+		b.Pix[i] |= 0x80 >> uint(x&7)
+	} else {
+		b.Pix[i] &^= 0x80 >> uint(x&7)
+	}
+}
+
+// BlendLevel thresholds a coverage sample into the bitmap: pixels at least
+// half covered take the ink, which is black for levels of 8 and above.
+func (b *Bitmap1bpp) BlendLevel(x, y int, ink, alpha uint8) {
+	if alpha < 8 {
+		return
+	}
+	b.Set(x, y, ink >= 8)
+}
+
+// Level returns the 0-15 level at x,y. Out-of-range reads return 0.
+func (b *Bitmap4bpp) Level(x, y int) uint8 {
+	if x < 0 || y < 0 || x >= b.Width || y >= b.Height {
+		return 0
+	}
+	v := b.Pix[y*b.Stride+x>>1]
+	if x&1 == 0 {
+		return v >> 4
+	}
+	return v & 0x0F
+}
+
+// SetLevel stores a 0-15 level at x,y, clamping larger values.
+// Out-of-range writes are ignored.
+func (b *Bitmap4bpp) SetLevel(x, y int, level uint8) {
+	if x < 0 || y < 0 || x >= b.Width || y >= b.Height {
+		return
+	}
+	if level > 15 {
+		level = 15
+	}
+	i := y*b.Stride + x>>1
+	if x&1 == 0 {
+		b.Pix[i] = (level << 4) | (b.Pix[i] & 0x0F)
+	} else {
+		b.Pix[i] = (b.Pix[i] & 0xF0) | level
+	}
+}
+
+// BlendLevel mixes ink into the pixel at x,y with alpha coverage (0-15).
+func (b *Bitmap4bpp) BlendLevel(x, y int, ink, alpha uint8) {
+	if alpha == 0 {
+		return
+	}
+	b.SetLevel(x, y, blend(b.Level(x, y), ink, alpha))
+}
+
+// Fill sets every pixel to level.
+func (b *Bitmap4bpp) Fill(level uint8) {
+	if level > 15 {
+		level = 15
+	}
+	fillBuffer(b.Pix, level<<4|level)
+}
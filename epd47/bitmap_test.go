@@ -0,0 +1,176 @@
+package epd47
+
+import (
+	"image"
+	"testing"
+
+	"github.com/abaschen/tinygo-epd47-s3/font"
+)
+
+// newTestDevice returns a configured device of the given size bound to no-op pins.
+func newTestDevice(w, h int) *Device {
+	cfg := Config{
+		Width:    w,
+		Height:   h,
+		CFG_DATA: mockPinOut,
+		CFG_CLK:  mockPinOut,
+		CFG_STR:  mockPinOut,
+		CKV:      mockPinOut,
+		STH:      mockPinOut,
+		CKH:      mockPinOut,
+		D0:       mockPinOut, D1: mockPinOut, D2: mockPinOut, D3: mockPinOut,
+		D4: mockPinOut, D5: mockPinOut, D6: mockPinOut, D7: mockPinOut,
+		SleepUS: mockSleep,
+	}
+	d := New(cfg)
+	d.Configure()
+	return d
+}
+
+func TestBitmap1bpp(t *testing.T) {
+	b := NewBitmap1bpp(10, 3)
+	if b.Stride != 2 || len(b.Pix) != 6 {
+		t.Fatalf("Expected stride 2 and 6 bytes, got %d and %d", b.Stride, len(b.Pix))
+	}
+
+	b.Set(0, 0, true)
+	b.Set(9, 2, true)
+	if b.Pix[0] != 0x80 || b.Pix[5] != 0x40 {
+		t.Errorf("Expected MSB-first packing, got %08b %08b", b.Pix[0], b.Pix[5])
+	}
+	if !b.Get(9, 2) || b.Get(8, 2) {
+		t.Error("Get does not match Set")
+	}
+
+	b.Set(0, 0, false)
+	if b.Get(0, 0) {
+		t.Error("Pixel should be cleared")
+	}
+
+	// Out of range is ignored.
+	b.Set(-1, 0, true)
+	b.Set(10, 0, true)
+	if b.Get(10, 0) {
+		t.Error("Out-of-range pixel should read false")
+	}
+}
+
+func TestBitmap4bpp(t *testing.T) {
+	b := NewBitmap4bpp(3, 2)
+	if b.Stride != 2 || len(b.Pix) != 4 {
+		t.Fatalf("Expected stride 2 and 4 bytes, got %d and %d", b.Stride, len(b.Pix))
+	}
+
+	b.SetLevel(0, 0, 0xA)
+	b.SetLevel(1, 0, 0x5)
+	b.SetLevel(2, 1, 20) // clamps to 15
+	if b.Pix[0] != 0xA5 || b.Pix[3] != 0xF0 {
+		t.Errorf("Expected high-nibble-first packing, got %02X %02X", b.Pix[0], b.Pix[3])
+	}
+	if b.Level(1, 0) != 5 || b.Level(2, 1) != 15 {
+		t.Error("Level does not match SetLevel")
+	}
+
+	b.Fill(0)
+	b.BlendLevel(0, 0, 15, 15)
+	b.BlendLevel(1, 0, 15, 8)
+	b.BlendLevel(2, 0, 15, 0)
+	if b.Level(0, 0) != 15 || b.Level(1, 0) != 8 || b.Level(2, 0) != 0 {
+		t.Errorf("Unexpected blend results %d %d %d", b.Level(0, 0), b.Level(1, 0), b.Level(2, 0))
+	}
+
+	// Blending white over black lightens.
+	b.SetLevel(0, 1, 15)
+	b.BlendLevel(0, 1, 0, 8)
+	if b.Level(0, 1) != 7 {
+		t.Errorf("Expected 7 after half white blend, got %d", b.Level(0, 1))
+	}
+}
+
+func TestTextRendering(t *testing.T) {
+	f := font.DejaVuSans16
+
+	b := NewBitmap4bpp(64, f.Ascent+f.Descent)
+	font.Draw(b, f, 0, f.Ascent, "Hi", 15)
+	inked := 0
+	for y := 0; y < b.Height; y++ {
+		for x := 0; x < b.Width; x++ {
+			if b.Level(x, y) != 0 {
+				inked++
+			}
+		}
+	}
+	if inked == 0 {
+		t.Error("Expected text to ink the 4bpp bitmap")
+	}
+
+	m := NewBitmap1bpp(64, f.Ascent+f.Descent)
+	font.Draw(m, f, 0, f.Ascent, "Hi", 15)
+	if m.Get(f.Width("Hi")+2, f.Ascent-1) {
+		t.Error("Expected no ink right of the text")
+	}
+
+	d := newTestDevice(100, 100)
+	// Should not panic, including odd x, clipping and empty strings.
+	d.DrawText(3, 5, "Hello", nil)
+	d.DrawText(90, 90, "Clipped", f)
+	d.DrawText(0, 0, "", nil)
+	d.DrawTextBox(image.Rect(-10, 10, 60, 60), "Wrapped text in a box", f, font.AlignCenter)
+	d.DrawTextBox(image.Rect(200, 200, 300, 300), "Off screen", f, font.AlignLeft)
+
+	// Banded rendering matches rendering the whole box at once.
+	d.SetReadback(true)
+	r := image.Rect(5, 3, 85, 97)
+	text := "Several lines of wrapped text, rendered in bands"
+	d.DrawTextBox(r, text, f, font.AlignCenter)
+	want := NewBitmap4bpp(100, 100)
+	font.DrawBox(want, f, r, text, font.AlignCenter, 15)
+	for y := 0; y < 100; y++ {
+		for x := 0; x < 100; x++ {
+			if got := d.Displayed().Level(x, y); got != want.Level(x, y) {
+				t.Fatalf("Pixel %d,%d: expected level %d, got %d", x, y, want.Level(x, y), got)
+			}
+		}
+	}
+}
+
+func TestShapes(t *testing.T) {
+	b := NewBitmap4bpp(10, 8)
+	b.StrokeRect(image.Rect(1, 1, 9, 7), 1, 15)
+	for _, p := range []image.Point{{1, 1}, {8, 1}, {1, 6}, {8, 6}, {4, 1}, {1, 3}} {
+		if b.Level(p.X, p.Y) != 15 {
+			t.Errorf("Expected outline at %v", p)
+		}
+	}
+	if b.Level(4, 3) != 0 || b.Level(0, 0) != 0 || b.Level(9, 7) != 0 {
+		t.Error("Expected the inside and outside of the outline blank")
+	}
+
+	// Clipped fills and lines stay inside the bitmap.
+	b.Fill(0)
+	b.FillRect(image.Rect(-5, 6, 3, 20), 7)
+	if b.Level(0, 7) != 7 || b.Level(2, 6) != 7 || b.Level(3, 6) != 0 {
+		t.Error("Unexpected clipped fill")
+	}
+
+	b.Fill(0)
+	b.Line(0, 0, 9, 3, 15)
+	n := 0
+	for y := 0; y < b.Height; y++ {
+		for x := 0; x < b.Width; x++ {
+			if b.Level(x, y) != 0 {
+				n++
+			}
+		}
+	}
+	if n != 10 || b.Level(0, 0) != 15 || b.Level(9, 3) != 15 {
+		t.Errorf("Expected a 10-pixel line between the end points, got %d pixels", n)
+	}
+
+	b.Fill(0)
+	b.HLine(6, 2, 0, 5)
+	b.VLine(0, 7, 5, 5)
+	if b.Level(2, 0) != 5 || b.Level(6, 0) != 5 || b.Level(7, 0) != 0 || b.Level(0, 5) != 5 || b.Level(0, 4) != 0 {
+		t.Error("Unexpected line extents")
+	}
+}
@@ -0,0 +1,193 @@
+package epd47
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"math"
+	"strconv"
+
+	"github.com/abaschen/tinygo-epd47-s3/font"
+)
+
+// Calibration records the gray each 4bpp ink level actually shows on a
+// panel, measured from DrawCalibrationWedge: entry i is the luminance
+// (0 black, 255 white) of level i. Values must not increase with the level.
+//
+// Conversions from 8-bit gray (DrawImage, Device.Set, calibrated
+// Ditherers) pick levels by these values instead of assuming evenly
+// spaced steps, which evens out the tone curve produced by contrast4.
+type Calibration [16]uint8
+
+// LinearCalibration is the uncalibrated mapping: evenly spaced levels, the
+// same one GrayToLevel and LevelToGray use.
+var LinearCalibration = Calibration{255, 238, 221, 204, 187, 170, 153, 136, 119, 102, 85, 68, 51, 34, 17, 0}
+
+// GammaCalibration approximates a panel whose levels follow a power curve:
+// level i shows 255 * (1 - i/15)^gamma. Gamma above 1 models levels that
+// darken quickly, below 1 levels that stay light.
+func GammaCalibration(gamma float64) Calibration {
+	if gamma <= 0 {
+		gamma = 1
+	}
+	var c Calibration
+	for i := range c {
+		c[i] = uint8(math.Round(255 * math.Pow(1-float64(i)/15, gamma)))
+	}
+	return c
+}
+
+// Errors returned when validating or loading a calibration.
+var (
+	ErrCalibrationOrder = errors.New("epd47: calibration grays must not increase with the level")
+	ErrCalibrationBlob  = errors.New("epd47: invalid calibration blob")
+)
+
+// Validate reports whether c can be used: grays must not increase with the
+// level and the lightest and darkest levels must differ.
+func (c *Calibration) Validate() error {
+	for i := 1; i < len(c); i++ {
+		if c[i] > c[i-1] {
+			return ErrCalibrationOrder
+		}
+	}
+	if c[0] == c[15] {
+		return ErrCalibrationOrder
+	}
+	return nil
+}
+
+// Level returns the ink level whose measured gray is nearest to gray.
+func (c *Calibration) Level(gray uint8) uint8 {
+	best, bestDiff := uint8(0), 256
+	for i, g := range c {
+		diff := int(g) - int(gray)
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff < bestDiff {
+			best, bestDiff = uint8(i), diff
+		}
+	}
+	return best
+}
+
+// Curve returns the 256-entry tone curve mapping 8-bit gray to the nearest
+// level, for code that converts many pixels without dithering.
+func (c *Calibration) Curve() [256]uint8 {
+	var lut [256]uint8
+	for v := range lut {
+		lut[v] = c.Level(uint8(v))
+	}
+	return lut
+}
+
+// Calibration blobs are "EPDC", a version byte, the 16 grays and a
+// little-endian CRC-32 (IEEE) of everything before it.
+const (
+	calibrationMagic   = "EPDC"
+	calibrationVersion = 1
+	calibrationBlobLen = len(calibrationMagic) + 1 + 16 + 4
+)
+
+// MarshalBinary encodes c as a 25-byte blob suitable for flash or NVS.
+func (c *Calibration) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, calibrationBlobLen)
+	b = append(b, calibrationMagic...)
+	b = append(b, calibrationVersion)
+	b = append(b, c[:]...)
+	return binary.LittleEndian.AppendUint32(b, crc32.ChecksumIEEE(b)), nil
+}
+
+// UnmarshalBinary decodes a blob written by MarshalBinary. c is left
+// unchanged if the blob is corrupt or the grays fail Validate.
+func (c *Calibration) UnmarshalBinary(b []byte) error {
+	if len(b) != calibrationBlobLen || string(b[:4]) != calibrationMagic || b[4] != calibrationVersion {
+		return ErrCalibrationBlob
+	}
+	n := calibrationBlobLen - 4
+	if binary.LittleEndian.Uint32(b[n:]) != crc32.ChecksumIEEE(b[:n]) {
+		return ErrCalibrationBlob
+	}
+	var v Calibration
+	copy(v[:], b[5:n])
+	if err := v.Validate(); err != nil {
+		return err
+	}
+	*c = v
+	return nil
+}
+
+// SetCalibration makes the Ditherer quantize against the measured grays
+// in c. A 2-level Ditherer uses the lightest and darkest entries.
+func (d *Ditherer) SetCalibration(c *Calibration) {
+	if d.levels == 2 {
+		d.setPalette([]uint8{c[0], c[15]})
+		return
+	}
+	d.setPalette(c[:])
+}
+
+// SetCalibration installs the panel's tone calibration for gray
+// conversions; nil restores LinearCalibration. A curve failing Validate is
+// rejected and the current one kept.
+func (d *Device) SetCalibration(c *Calibration) error {
+	if c == nil {
+		d.cal = nil
+		return nil
+	}
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	v := *c
+	d.cal = &v
+	return nil
+}
+
+// newDitherer returns a Ditherer using the device calibration, if any.
+func (d *Device) newDitherer(method DitherMethod, width, levels int) *Ditherer {
+	dd := NewDitherer(method, width, levels)
+	if d.cal != nil {
+		dd.SetCalibration(d.cal)
+	}
+	return dd
+}
+
+// grayToLevel maps gray to a level through the device calibration.
+func (d *Device) grayToLevel(gray uint8) uint8 {
+	if d.cal != nil {
+		return d.cal.Level(gray)
+	}
+	return GrayToLevel(gray)
+}
+
+// DrawCalibrationWedge draws the 16 ink levels as equal vertical steps
+// across r, lightest on the left, each labelled with its level. Measure
+// each step's gray (a scanner or an evenly lit photo works) into a
+// Calibration. The wedge is drawn uncalibrated.
+func (d *Device) DrawCalibrationWedge(r image.Rectangle) {
+	vis := r.Intersect(image.Rect(0, 0, d.w, d.h))
+	if vis.Empty() {
+		return
+	}
+	bm := NewBitmap4bpp(vis.Dx(), vis.Dy())
+	box := r.Sub(vis.Min)
+	f := DefaultFont
+	for i := 0; i < 16; i++ {
+		step := image.Rect(box.Min.X+i*box.Dx()/16, box.Min.Y, box.Min.X+(i+1)*box.Dx()/16, box.Max.Y)
+		for y := step.Min.Y; y < step.Max.Y; y++ {
+			for x := step.Min.X; x < step.Max.X; x++ {
+				bm.SetLevel(x, y, uint8(i))
+			}
+		}
+		ink := uint8(15)
+		if i >= 8 {
+			ink = 0
+		}
+		label := step
+		label.Min.Y += 4
+		font.DrawBox(bm, f, label, strconv.Itoa(i), font.AlignCenter, ink)
+	}
+	d.DrawImage4bpp(vis.Min.X, vis.Min.Y, bm.Width, bm.Height, bm.Pix, BlackOnWhite)
+}
@@ -0,0 +1,143 @@
+package epd47
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+// testCalibration is a panel whose light levels are bunched together.
+var testCalibration = Calibration{250, 245, 240, 235, 228, 220, 205, 190, 170, 150, 125, 100, 75, 50, 25, 10}
+
+func TestCalibrationLevel(t *testing.T) {
+	for l := uint8(0); l < 16; l++ {
+		if got := LinearCalibration.Level(LevelToGray(l).Y); got != l {
+			t.Errorf("Linear: expected level %d, got %d", l, got)
+		}
+	}
+
+	c := testCalibration
+	if c.Level(255) != 0 || c.Level(0) != 15 {
+		t.Errorf("Expected extremes to clamp, got %d and %d", c.Level(255), c.Level(0))
+	}
+	if c.Level(200) != 6 {
+		t.Errorf("Expected gray 200 to pick level 6, got %d", c.Level(200))
+	}
+	curve := c.Curve()
+	if curve[200] != 6 || curve[128] != 10 {
+		t.Errorf("Unexpected curve entries %d %d", curve[200], curve[128])
+	}
+
+	g := GammaCalibration(2)
+	if g[0] != 255 || g[15] != 0 || g[8] >= LinearCalibration[8] {
+		t.Errorf("Unexpected gamma calibration %v", g)
+	}
+	if err := g.Validate(); err != nil {
+		t.Errorf("Expected gamma calibration to validate, got %v", err)
+	}
+}
+
+func TestCalibrationValidate(t *testing.T) {
+	bad := LinearCalibration
+	bad[3] = 250
+	if bad.Validate() != ErrCalibrationOrder {
+		t.Error("Expected rising grays to be rejected")
+	}
+	var flat Calibration
+	if flat.Validate() != ErrCalibrationOrder {
+		t.Error("Expected a flat calibration to be rejected")
+	}
+}
+
+func TestCalibrationBlob(t *testing.T) {
+	b, err := testCalibration.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary failed: %v", err)
+	}
+	if len(b) != 25 || string(b[:4]) != "EPDC" {
+		t.Fatalf("Unexpected blob % x", b)
+	}
+
+	var c Calibration
+	if err := c.UnmarshalBinary(b); err != nil {
+		t.Fatalf("UnmarshalBinary failed: %v", err)
+	}
+	if c != testCalibration {
+		t.Errorf("Expected %v, got %v", testCalibration, c)
+	}
+
+	b[10] ^= 1
+	c = LinearCalibration
+	if err := c.UnmarshalBinary(b); err != ErrCalibrationBlob {
+		t.Errorf("Expected a CRC error, got %v", err)
+	}
+	if c != LinearCalibration {
+		t.Error("Expected a failed load to leave the calibration unchanged")
+	}
+	if err := c.UnmarshalBinary(b[:20]); err != ErrCalibrationBlob {
+		t.Errorf("Expected a length error, got %v", err)
+	}
+}
+
+func TestCalibratedDitherer(t *testing.T) {
+	// Gray 235 is exactly level 3 on the test panel but level 1 linearly.
+	dd := NewDitherer(DitherBayer8, 8, 16)
+	dd.SetCalibration(&testCalibration)
+	row := []uint8{235, 235, 235, 235, 235, 235, 235, 235}
+	dd.Row(row, row)
+	for _, v := range row {
+		if v != 3 {
+			t.Fatalf("Expected level 3 everywhere, got %v", row)
+		}
+	}
+
+	// 1bpp ditherers use the lightest and darkest measured grays: with a
+	// black that only reaches 10, gray 20 needs more ink than linearly.
+	avg, _ := ditherFlat(DitherFloydSteinberg, 64, 2, 20)
+	dd = NewDitherer(DitherFloydSteinberg, 64, 2)
+	dd.SetCalibration(&testCalibration)
+	src := make([]uint8, 64)
+	for x := range src {
+		src[x] = 20
+	}
+	out := make([]uint8, 64)
+	sum := 0
+	for y := 0; y < 64; y++ {
+		dd.Row(out, src)
+		for _, v := range out {
+			sum += int(v)
+		}
+	}
+	if calAvg := float64(sum) / (64 * 64); calAvg <= avg {
+		t.Errorf("Expected more ink with a calibrated black point, got %.3f vs %.3f", calAvg, avg)
+	}
+}
+
+func TestDeviceCalibration(t *testing.T) {
+	d := newTestDevice(100, 100)
+	if err := d.SetCalibration(&testCalibration); err != nil {
+		t.Fatalf("SetCalibration failed: %v", err)
+	}
+	flat := Calibration{}
+	if err := d.SetCalibration(&flat); err != ErrCalibrationOrder {
+		t.Errorf("Expected ErrCalibrationOrder for a flat curve, got %v", err)
+	}
+	d.Set(1, 1, color.Gray{Y: 200})
+	if d.GetGrayscalePixel(1, 1) != 6 {
+		t.Errorf("Expected calibrated level 6, got %d", d.GetGrayscalePixel(1, 1))
+	}
+	if d.At(1, 1).(color.Gray).Y != 205 {
+		t.Errorf("Expected measured gray 205, got %v", d.At(1, 1))
+	}
+
+	d.SetCalibration(nil)
+	d.Set(2, 2, color.Gray{Y: 200})
+	if d.GetGrayscalePixel(2, 2) != GrayToLevel(200) {
+		t.Errorf("Expected linear level after reset, got %d", d.GetGrayscalePixel(2, 2))
+	}
+
+	// Should not panic, including odd and clipped rectangles.
+	d.DrawCalibrationWedge(image.Rect(0, 0, 100, 40))
+	d.DrawCalibrationWedge(image.Rect(-5, 61, 131, 120))
+	d.DrawCalibrationWedge(image.Rect(200, 200, 300, 300))
+}
@@ -0,0 +1,211 @@
+package epd47
+
+import "image"
+
+// AlphaMask gives a layer's coverage per pixel, 0 transparent to 15
+// opaque. Bitmap1bpp (set bits opaque) and Bitmap4bpp (level as alpha)
+// both implement it.
+type AlphaMask interface {
+	Alpha(x, y int) uint8
+}
+
+// Alpha implements AlphaMask: inked pixels are opaque.
+func (b *Bitmap1bpp) Alpha(x, y int) uint8 {
+	if b.Get(x, y) {
+		return 15
+	}
+	return 0
+}
+
+// Alpha implements AlphaMask with the level as coverage.
+func (b *Bitmap4bpp) Alpha(x, y int) uint8 { return b.Level(x, y) }
+
+// Layer is one image in a Canvas stack, placed with its top-left corner at
+// Pos. With Image set, the layer shows its levels; with Image nil it is a
+// solid Ink fill, which with a 1bpp Mask is the cheap way to overlay text
+// or icons. A nil Mask makes the layer opaque over its whole area.
+type Layer struct {
+	Name   string
+	Pos    image.Point
+	Image  *Bitmap4bpp
+	Mask   AlphaMask
+	Ink    uint8
+	Hidden bool
+
+	// Size of a solid layer without an Image; taken from the Mask when it
+	// is a bitmap and Size is empty.
+	Size image.Point
+}
+
+// Bounds returns the canvas area the layer covers.
+func (l *Layer) Bounds() image.Rectangle {
+	var size image.Point
+	switch m := l.Mask.(type) {
+	case *Bitmap1bpp:
+		size = image.Pt(m.Width, m.Height)
+	case *Bitmap4bpp:
+		size = image.Pt(m.Width, m.Height)
+	}
+	if l.Size != (image.Point{}) {
+		size = l.Size
+	}
+	if l.Image != nil {
+		size = image.Pt(l.Image.Width, l.Image.Height)
+	}
+	return image.Rectangle{Min: l.Pos, Max: l.Pos.Add(size)}
+}
+
+// Canvas composites a stack of layers, bottom first, over a background
+// level into one 4bpp image, so overlapping content reaches the panel in a
+// single update instead of one 15-frame pass per element.
+//
+// A Canvas is a RowSource: DrawRows4bpp composites each row on the fly for
+// every frame, trading CPU for not holding the composited image in RAM.
+// DrawCanvas composites once into a bitmap instead.
+type Canvas struct {
+	Width, Height int
+	Background    uint8
+
+	layers []*Layer
+	row    []uint8 // one composited row of levels
+}
+
+// NewCanvas returns an empty w x h canvas on a white background.
+func NewCanvas(w, h int) *Canvas {
+	if w < 0 {
+		w = 0
+	}
+	if h < 0 {
+		h = 0
+	}
+	return &Canvas{Width: w, Height: h, row: make([]uint8, w)}
+}
+
+// Push adds l on top of the stack and returns it.
+func (c *Canvas) Push(l *Layer) *Layer {
+	c.layers = append(c.layers, l)
+	return l
+}
+
+// Insert adds l at index i of the stack, 0 being the bottom.
+func (c *Canvas) Insert(i int, l *Layer) *Layer {
+	i = max(0, min(i, len(c.layers)))
+	c.layers = append(c.layers, nil)
+	copy(c.layers[i+1:], c.layers[i:])
+	c.layers[i] = l
+	return l
+}
+
+// Remove takes l off the stack and reports whether it was there.
+func (c *Canvas) Remove(l *Layer) bool {
+	for i, v := range c.layers {
+		if v == l {
+			c.layers = append(c.layers[:i], c.layers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Layer returns the topmost layer called name, or nil.
+func (c *Canvas) Layer(name string) *Layer {
+	for i := len(c.layers) - 1; i >= 0; i-- {
+		if c.layers[i].Name == name {
+			return c.layers[i]
+		}
+	}
+	return nil
+}
+
+// Layers returns the stack, bottom first. The slice is the canvas's own.
+func (c *Canvas) Layers() []*Layer { return c.layers }
+
+// compositeRow fills c.row with the composited levels of row y, growing it
+// for canvases not made by NewCanvas or widened since.
+func (c *Canvas) compositeRow(y int) {
+	w := max(c.Width, 0)
+	if cap(c.row) < w {
+		c.row = make([]uint8, w)
+	}
+	c.row = c.row[:w]
+	row := c.row
+	bg := min(c.Background, 15)
+	for x := range row {
+		row[x] = bg
+	}
+	for _, l := range c.layers {
+		if l.Hidden {
+			continue
+		}
+		r := l.Bounds()
+		if y < r.Min.Y || y >= r.Max.Y {
+			continue
+		}
+		ly := y - r.Min.Y
+		x0, x1 := max(r.Min.X, 0), min(r.Max.X, c.Width)
+		for x := x0; x < x1; x++ {
+			lx := x - r.Min.X
+			a := uint8(15)
+			if l.Mask != nil {
+				a = l.Mask.Alpha(lx, ly)
+			}
+			if a == 0 {
+				continue
+			}
+			ink := l.Ink
+			if l.Image != nil {
+				ink = l.Image.Level(lx, ly)
+			}
+			row[x] = blend(row[x], ink, a)
+		}
+	}
+}
+
+// blend mixes ink over old with alpha coverage (0-15), rounded to the
+// nearest level.
+func blend(old, ink, alpha uint8) uint8 {
+	if alpha >= 15 {
+		return min(ink, 15)
+	}
+	d := (int(ink) - int(old)) * int(alpha)
+	if d >= 0 {
+		d += 7
+	} else {
+		d -= 7
+	}
+	return uint8(int(old) + d/15)
+}
+
+// ReadRow implements RowSource with packed 4bpp rows of the composite.
+func (c *Canvas) ReadRow(y int, dst []byte) error {
+	stride := (c.Width + 1) / 2
+	if y < 0 || y >= c.Height || len(dst) < stride {
+		return ErrShortData
+	}
+	c.compositeRow(y)
+	clear(dst[:stride])
+	for x, l := range c.row {
+		dst[x>>1] |= l << (4 - 4*(x&1))
+	}
+	return nil
+}
+
+// Composite renders the stack into dst, clipped to both sizes.
+func (c *Canvas) Composite(dst *Bitmap4bpp) {
+	h := min(c.Height, dst.Height)
+	w := max(0, min(c.Width, dst.Width))
+	for y := 0; y < h; y++ {
+		c.compositeRow(y)
+		for x, l := range c.row[:w] {
+			dst.SetLevel(x, y, l)
+		}
+	}
+}
+
+// DrawCanvas composites c into one bitmap and draws it with its top-left
+// corner at x,y in a single 4bpp update.
+func (d *Device) DrawCanvas(c *Canvas, x, y int, mode DrawMode) {
+	bm := NewBitmap4bpp(c.Width, c.Height)
+	c.Composite(bm)
+	d.DrawImage4bpp(x, y, bm.Width, bm.Height, bm.Pix, mode)
+}
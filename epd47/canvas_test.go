@@ -0,0 +1,116 @@
+package epd47
+
+import (
+	"image"
+	"testing"
+)
+
+func TestCanvasStacking(t *testing.T) {
+	c := NewCanvas(8, 2)
+	c.Background = 2
+
+	photo := NewBitmap4bpp(4, 2)
+	photo.Fill(6)
+	c.Push(&Layer{Name: "photo", Pos: image.Pt(2, 0), Image: photo})
+
+	// A solid black layer through a 1bpp mask, like overlaid text.
+	mask := NewBitmap1bpp(8, 2)
+	mask.Set(3, 0, true)
+	mask.Set(7, 1, true)
+	text := c.Push(&Layer{Name: "text", Ink: 15, Mask: mask})
+
+	// Half-transparent white veil through a 4bpp mask.
+	veil := NewBitmap4bpp(1, 1)
+	veil.Fill(8)
+	c.Push(&Layer{Name: "veil", Pos: image.Pt(6, 1), Ink: 0, Mask: veil})
+
+	out := NewBitmap4bpp(8, 2)
+	c.Composite(out)
+	want := [][]uint8{
+		{2, 2, 6, 15, 6, 6, 2, 2},
+		{2, 2, 6, 6, 6, 6, 1, 15},
+	}
+	for y, row := range want {
+		for x, l := range row {
+			if out.Level(x, y) != l {
+				t.Errorf("Pixel %d,%d: expected %d, got %d", x, y, l, out.Level(x, y))
+			}
+		}
+	}
+
+	// Hiding and removing layers.
+	text.Hidden = true
+	c.Composite(out)
+	if out.Level(3, 0) != 6 {
+		t.Errorf("Expected the hidden text layer to disappear, got %d", out.Level(3, 0))
+	}
+	if !c.Remove(c.Layer("photo")) || c.Remove(&Layer{}) {
+		t.Error("Unexpected Remove results")
+	}
+	c.Composite(out)
+	if out.Level(2, 0) != 2 || len(c.Layers()) != 2 {
+		t.Errorf("Expected background after removing the photo, got %d", out.Level(2, 0))
+	}
+
+	// Insert at the bottom goes under everything.
+	c.Insert(0, &Layer{Name: "base", Ink: 4, Size: image.Pt(8, 2)})
+	if c.Layers()[0].Name != "base" || c.Layer("missing") != nil {
+		t.Error("Expected base at the bottom of the stack")
+	}
+	c.Composite(out)
+	if out.Level(0, 0) != 4 {
+		t.Errorf("Expected the base layer to cover the background, got %d", out.Level(0, 0))
+	}
+}
+
+func TestCanvasClippingAndRows(t *testing.T) {
+	c := NewCanvas(5, 3)
+	img := NewBitmap4bpp(4, 4)
+	img.Fill(9)
+	c.Push(&Layer{Pos: image.Pt(-2, 1), Image: img})
+	c.Push(&Layer{Pos: image.Pt(4, -1), Ink: 15, Size: image.Pt(3, 3)})
+
+	// Rows from ReadRow match Composite.
+	out := NewBitmap4bpp(5, 3)
+	c.Composite(out)
+	row := make([]byte, 3)
+	for y := 0; y < 3; y++ {
+		if err := c.ReadRow(y, row); err != nil {
+			t.Fatalf("ReadRow failed: %v", err)
+		}
+		if string(row) != string(out.Pix[y*3:(y+1)*3]) {
+			t.Errorf("Row %d: ReadRow % x differs from Composite % x", y, row, out.Pix[y*3:(y+1)*3])
+		}
+	}
+	if out.Level(0, 1) != 9 || out.Level(2, 1) != 0 || out.Level(4, 1) != 15 || out.Level(4, 2) != 0 {
+		t.Errorf("Unexpected clipped composite % x", out.Pix)
+	}
+	if err := c.ReadRow(3, row); err != ErrShortData {
+		t.Errorf("Expected ErrShortData past the bottom, got %v", err)
+	}
+
+	d := newTestDevice(100, 100)
+	d.DrawCanvas(c, 10, 10, BlackOnWhite)
+	if err := d.DrawRows4bpp(10, 10, c.Width, c.Height, c, BlackOnWhite); err != nil {
+		t.Errorf("Streaming a canvas failed: %v", err)
+	}
+}
+
+func TestCanvasLiteral(t *testing.T) {
+	c := &Canvas{Width: 4, Height: 2, Background: 3}
+	out := NewBitmap4bpp(4, 2)
+	c.Composite(out)
+	if out.Level(3, 1) != 3 {
+		t.Errorf("Expected the background, got %d", out.Level(3, 1))
+	}
+
+	// Widening after the first composite grows the row buffer.
+	c.Width = 6
+	dst := make([]byte, 3)
+	if err := c.ReadRow(0, dst); err != nil {
+		t.Fatal(err)
+	}
+	if dst[2] != 0x33 {
+		t.Errorf("Expected the widened row filled, got %#x", dst[2])
+	}
+}
@@ -40,6 +40,14 @@ type Config struct {
 
 	// Sleep function in microseconds.
 	SleepUS SleepUS
+
+	// Optional. Called by PowerOffAll after every line is driven low, to
+	// switch the pins to inputs (high-Z) for sleep; pull-downs keep the
+	// lines at their parked level. Used only together with AcquirePins.
+	ReleasePins func()
+	// Called by the next PowerOn after ReleasePins, to make the pins
+	// outputs again.
+	AcquirePins func()
 }
 
 // Device represents the ED047TC1 e-paper panel interface.
@@ -56,15 +64,30 @@ type Device struct {
 	line1b [MaxWidthBytes1bpp]byte
 	// 4bpp panel-lane formatted output: width/2 bytes per line.
 	line4b [MaxWidthBytes4bpp]byte
+	// One packed source row for RowSource draws.
+	row [MaxWidthBytes4bpp]byte
 
 	// LUT for 4bpp conversion - moved from global to instance
 	// Use smaller LUT for constrained targets
 	convLUT [1 << 12]byte // 4KB instead of 64KB
 
-	// Sparse pixel buffers for interface compliance
-	// Only stores non-zero/non-false pixels to minimize memory usage
-	pixelBuffer     map[uint32]bool  // 1bpp pixels (key: y<<16|x)
-	grayscaleBuffer map[uint32]uint8 // 4bpp pixels (key: y<<16|x)
+	// Sparse pixel buffer for interface compliance, shared by SetPixel and
+	// SetGrayscalePixel: the last write to a pixel wins and a set 1bpp pixel
+	// is level 15. Only stores drawn (non-zero) pixels to minimize memory usage
+	pending map[uint32]uint8 // levels 1-15 (key: y<<16|x)
+
+	// Tone calibration for gray conversions; nil means LinearCalibration.
+	cal *Calibration
+
+	// Model of the levels on the panel, kept only after SetReadback(true).
+	shown *Bitmap4bpp
+
+	// Updates since the last full Clear.
+	ghost Ghosting
+
+	// Optional supply check before updates; see PowerGuard.
+	guard           *PowerGuard
+	halting, halted bool
 }
 
 // Hardware/format limits for this panel.
@@ -86,6 +109,9 @@ type parallelBus struct {
 	dataPins [8]PinOut
 	// Sleep function
 	sleepUS SleepUS
+	// Optional pin release for shutdown and reacquire for PowerOn
+	releasePins, acquirePins func()
+	released                 bool
 }
 
 // DefaultConfig returns a baseline configuration for the T5 4.7" panel.
@@ -136,6 +162,9 @@ func New(cfg Config) *Device {
 		ckh:      cfg.CKH,
 		dataPins: dataPins,
 		sleepUS:  sl,
+
+		releasePins: cfg.ReleasePins,
+		acquirePins: cfg.AcquirePins,
 	}
 
 	d := &Device{
@@ -166,9 +195,8 @@ func (d *Device) Configure() error {
 	clear(d.line4b[:])
 	clear(d.convLUT[:])
 	
-	// Initialize pixel buffers (lazy initialization - they'll be created when needed)
-	d.pixelBuffer = nil
-	d.grayscaleBuffer = nil
+	// Initialize pixel buffer (lazy initialization - it's created when needed)
+	d.pending = nil
 	
 	// Push initial (all-safed) config.
 	d.pushCfg()
@@ -207,183 +235,96 @@ func fillBuffer(buf []byte, value byte) {
 	}
 }
 
-// renderPixelBuffer converts sparse pixel buffer to display format and renders it
-func (d *Device) renderPixelBuffer() error {
-	if len(d.pixelBuffer) == 0 {
+// renderPending draws the sparse pixel buffer in one update: a 1bpp pass
+// when every pixel is black, otherwise one 4bpp pass over the bounding box
+// in which 1bpp pixels are level 15.
+func (d *Device) renderPending() error {
+	if len(d.pending) == 0 {
 		return nil
 	}
 	
 	// Find bounding box to minimize rendering area
-	minX, minY := int16(d.w), int16(d.h)
-	maxX, maxY := int16(0), int16(0)
-	
-	for key := range d.pixelBuffer {
-		x := int16(key & 0xFFFF)
-		y := int16(key >> 16)
+	minX, minY := d.w, d.h
+	maxX, maxY := 0, 0
+	black := true
+	for key, v := range d.pending {
+		x := int(key & 0xFFFF)
+		y := int(key >> 16)
 		if x < minX { minX = x }
 		if x > maxX { maxX = x }
 		if y < minY { minY = y }
 		if y > maxY { maxY = y }
+		if v != 15 { black = false }
 	}
 	
-	// Calculate render area
-	w := int(maxX - minX + 1)
-	h := int(maxY - minY + 1)
-	
-	// Create bitmap for the bounding box
-	stride := (w + 7) / 8
-	bitmap := make([]byte, stride*h)
-	
-	// Fill bitmap from sparse buffer
-	for key, value := range d.pixelBuffer {
-		if !value { continue } // Skip false pixels
-		
-		x := int16(key & 0xFFFF) - minX
-		y := int16(key >> 16) - minY
-		
-		byteIdx := int(y)*stride + int(x>>3)
-		bit := 7 - (int(x) & 7)
-		bitmap[byteIdx] |= (1 << bit)
-	}
-	
-	// Render to display
-	d.Draw1bpp(int(minX), int(minY), w, h, bitmap, 10)
-	
-	// Clear the buffer after rendering
-	clear(d.pixelBuffer)
-	
-	return nil
-}
-
-// renderGrayscaleBuffer converts sparse grayscale buffer to display format and renders it
-func (d *Device) renderGrayscaleBuffer() error {
-	if len(d.grayscaleBuffer) == 0 {
-		return nil
-	}
-	
-	// Find bounding box
-	minX, minY := int16(d.w), int16(d.h)
-	maxX, maxY := int16(0), int16(0)
-	
-	for key := range d.grayscaleBuffer {
-		x := int16(key & 0xFFFF)
-		y := int16(key >> 16)
-		if x < minX { minX = x }
-		if x > maxX { maxX = x }
-		if y < minY { minY = y }
-		if y > maxY { maxY = y }
-	}
-	
-	// Calculate render area
-	w := int(maxX - minX + 1)
-	h := int(maxY - minY + 1)
-	
-	// Create 4bpp bitmap for the bounding box
-	stride := (w + 1) / 2
-	bitmap := make([]byte, stride*h)
-	
-	// Fill bitmap from sparse buffer
-	for key, value := range d.grayscaleBuffer {
-		if value == 0 { continue } // Skip zero pixels
-		
-		x := int16(key & 0xFFFF) - minX
-		y := int16(key >> 16) - minY
-		
-		byteIdx := int(y)*stride + int(x>>1)
-		if int(x)&1 == 0 {
-			// Even column - upper nibble
-			bitmap[byteIdx] = (value << 4) | (bitmap[byteIdx] & 0x0F)
-		} else {
-			// Odd column - lower nibble
-			bitmap[byteIdx] = (bitmap[byteIdx] & 0xF0) | (value & 0x0F)
+	var err error
+	if black {
+		bm := NewBitmap1bpp(maxX-minX+1, maxY-minY+1)
+		for key := range d.pending {
+			bm.Set(int(key&0xFFFF)-minX, int(key>>16)-minY, true)
 		}
+		err = d.DrawRows1bpp(minX, minY, bm.Width, bm.Height, bm, 10)
+	} else {
+		bm := NewBitmap4bpp(maxX-minX+1, maxY-minY+1)
+		for key, v := range d.pending {
+			bm.SetLevel(int(key&0xFFFF)-minX, int(key>>16)-minY, v)
+		}
+		err = d.DrawRows4bpp(minX, minY, bm.Width, bm.Height, bm, BlackOnWhite)
+	}
+	if err != nil {
+		// Keep the pixels for the next Display, e.g. after ErrLowPower
+		return err
 	}
-	
-	// Render to display
-	d.DrawImage4bpp(int(minX), int(minY), w, h, bitmap, BlackOnWhite)
 	
 	// Clear the buffer after rendering
-	clear(d.grayscaleBuffer)
+	clear(d.pending)
 	
 	return nil
 }
+
 // Size returns the display dimensions as required by Displayer interface
 func (d *Device) Size() (x, y int16) {
 	return int16(d.w), int16(d.h)
 }
 
-// Display updates the screen with accumulated pixels from SetPixel/SetGrayscalePixel calls
+// Display updates the screen with accumulated pixels from SetPixel/SetGrayscalePixel calls.
+// If the draw is skipped (ErrLowPower) the pixels are kept for the next call.
 func (d *Device) Display() error {
-	// Render 1bpp pixels if any exist
-	if d.pixelBuffer != nil && len(d.pixelBuffer) > 0 {
-		err := d.renderPixelBuffer()
-		if err != nil {
-			return err
-		}
-	}
-	
-	// Render 4bpp pixels if any exist
-	if d.grayscaleBuffer != nil && len(d.grayscaleBuffer) > 0 {
-		err := d.renderGrayscaleBuffer()
-		if err != nil {
-			return err
-		}
-	}
-	
-	return nil
+	// Render 1bpp and 4bpp pixels together if any exist
+	return d.renderPending()
 }
 
-// ClearDisplay clears the entire display and pixel buffers
+// ClearDisplay clears the entire display and pixel buffers.
+// It returns ErrLowPower, keeping the buffer, when a power guard skips the clear.
 func (d *Device) ClearDisplay() error {
 	// Clear the physical display
-	d.Clear(2)
-	
-	// Clear pixel buffers
-	if d.pixelBuffer != nil {
-		clear(d.pixelBuffer)
-	}
-	if d.grayscaleBuffer != nil {
-		clear(d.grayscaleBuffer)
+	if err := d.clearScreen(2); err != nil {
+		return err
 	}
 	
+	// Clear pixel buffer
+	clear(d.pending)
+	
 	return nil
 }
 
 // SetPixel sets a single pixel in the internal buffer (1bpp)
 // This implementation uses a sparse pixel buffer to avoid full framebuffer memory usage.
+// A set pixel is stored as level 15 in the same buffer as SetGrayscalePixel,
+// so the last write to a pixel wins.
 // Call Display() to render accumulated pixels to the e-paper display.
 func (d *Device) SetPixel(x, y int16, c bool) {
-	if x < 0 || y < 0 || int(x) >= d.w || int(y) >= d.h {
-		return
-	}
-	
-	// Initialize pixel buffer if needed
-	if d.pixelBuffer == nil {
-		d.pixelBuffer = make(map[uint32]bool)
-	}
-	
-	// Use a single uint32 key to store x,y coordinates
-	key := (uint32(y) << 16) | uint32(x)
-	
 	if c {
-		d.pixelBuffer[key] = true
+		d.SetGrayscalePixel(x, y, 15)
 	} else {
-		delete(d.pixelBuffer, key) // Remove false pixels to save memory
+		d.SetGrayscalePixel(x, y, 0)
 	}
 }
 
 // GetPixel gets a single pixel state from the internal buffer
+// Pixels at level 8 and above read as set, matching the 1bpp threshold.
 func (d *Device) GetPixel(x, y int16) bool {
-	if x < 0 || y < 0 || int(x) >= d.w || int(y) >= d.h {
-		return false
-	}
-	
-	if d.pixelBuffer == nil {
-		return false
-	}
-	
-	key := (uint32(y) << 16) | uint32(x)
-	return d.pixelBuffer[key] // Returns false if key doesn't exist
+	return d.GetGrayscalePixel(x, y) >= 8
 }
 
 // SetGrayscalePixel sets a pixel with grayscale value (0-15)
@@ -398,17 +339,18 @@ func (d *Device) SetGrayscalePixel(x, y int16, c uint8) {
 		c = 15
 	}
 	
-	// Initialize grayscale buffer if needed
-	if d.grayscaleBuffer == nil {
-		d.grayscaleBuffer = make(map[uint32]uint8)
+	// Initialize pixel buffer if needed
+	if d.pending == nil {
+		d.pending = make(map[uint32]uint8)
 	}
 	
+	// Use a single uint32 key to store x,y coordinates
 	key := (uint32(y) << 16) | uint32(x)
 	
 	if c == 0 {
-		delete(d.grayscaleBuffer, key) // Remove zero pixels to save memory
+		delete(d.pending, key) // Remove zero pixels to save memory
 	} else {
-		d.grayscaleBuffer[key] = c
+		d.pending[key] = c
 	}
 }
 
@@ -418,10 +360,6 @@ func (d *Device) GetGrayscalePixel(x, y int16) uint8 {
 		return 0
 	}
 	
-	if d.grayscaleBuffer == nil {
-		return 0
-	}
-	
 	key := (uint32(y) << 16) | uint32(x)
-	return d.grayscaleBuffer[key] // Returns 0 if key doesn't exist
-}
\ No newline at end of file
+	return d.pending[key] // Returns 0 if key doesn't exist
+}
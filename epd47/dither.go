@@ -0,0 +1,256 @@
+package epd47
+
+// DitherMethod selects how a Ditherer hides quantization error.
+type DitherMethod uint8
+
+const (
+	DitherNone           DitherMethod = iota // nearest level
+	DitherFloydSteinberg                     // error diffusion, serpentine scan
+	DitherAtkinson                           // error diffusion keeping 3/4 of the error: crisper, lighter shadows
+	DitherBayer4                             // ordered, 4x4 Bayer matrix
+	DitherBayer8                             // ordered, 8x8 Bayer matrix
+	DitherBlueNoise                          // ordered, 32x32 blue-noise threshold map
+)
+
+var ditherNames = [...]string{"none", "floyd-steinberg", "atkinson", "bayer4", "bayer8", "blue-noise"}
+
+// String returns the method name accepted by ParseDitherMethod.
+func (m DitherMethod) String() string {
+	if int(m) < len(ditherNames) {
+		return ditherNames[m]
+	}
+	return "unknown"
+}
+
+// ParseDitherMethod looks a method up by name. "fs" and "bluenoise" are
+// accepted as short forms.
+func ParseDitherMethod(s string) (DitherMethod, bool) {
+	switch s {
+	case "fs":
+		return DitherFloydSteinberg, true
+	case "bluenoise":
+		return DitherBlueNoise, true
+	}
+	for i, n := range ditherNames {
+		if n == s {
+			return DitherMethod(i), true
+		}
+	}
+	return DitherNone, false
+}
+
+// Ditherer quantizes 8-bit gray rows (0 black, 255 white) into ink levels
+// (0 white, levels-1 black) one row at a time, so images can be converted
+// while they stream to the panel without an 8-bit frame buffer. Error
+// diffusion keeps at most three rows of error terms.
+//
+// Rows must be fed top to bottom; call Reset before starting a new pass.
+type Ditherer struct {
+	method DitherMethod
+	levels int
+	y      int
+
+	// gray is the value each ink level shows. For every input gray, base
+	// is the nearest level at least as light and frac (0-255) how far the
+	// input sits towards the next darker level.
+	gray [16]uint8
+	base [256]uint8
+	frac [256]uint8
+
+	// Error rows for diffusion: the current row and the two below it,
+	// padded by two columns on each side.
+	err [3][]int16
+}
+
+// NewDitherer returns a Ditherer for rows of width pixels producing 16
+// levels (4bpp) or 2 levels (1bpp).
+func NewDitherer(method DitherMethod, width, levels int) *Ditherer {
+	if levels != 2 {
+		levels = 16
+	}
+	d := &Ditherer{method: method, levels: levels}
+	if method == DitherFloydSteinberg || method == DitherAtkinson {
+		for i := range d.err {
+			d.err[i] = make([]int16, width+4)
+		}
+	}
+	var pal [16]uint8
+	for i := 0; i < levels; i++ {
+		pal[i] = uint8(255 - i*255/(levels-1))
+	}
+	d.setPalette(pal[:levels])
+	return d
+}
+
+// setPalette installs the gray values shown by each ink level, which must
+// get darker with increasing level.
+func (d *Ditherer) setPalette(gray []uint8) {
+	copy(d.gray[:], gray)
+	n := len(gray)
+	for v := 0; v < 256; v++ {
+		i := 0
+		for i < n-1 && int(gray[i+1]) >= v {
+			i++
+		}
+		d.base[v] = uint8(i)
+		d.frac[v] = 0
+		if i < n-1 && int(gray[i]) > v {
+			d.frac[v] = uint8((int(gray[i]) - v) * 255 / (int(gray[i]) - int(gray[i+1])))
+		}
+	}
+}
+
+// Reset restarts the ditherer at row 0 and drops pending error.
+func (d *Ditherer) Reset() {
+	d.y = 0
+	for _, e := range d.err {
+		clear(e)
+	}
+}
+
+// nearest returns the level closest to gray v.
+func (d *Ditherer) nearest(v uint8) uint8 {
+	if d.frac[v] >= 128 {
+		return d.base[v] + 1
+	}
+	return d.base[v]
+}
+
+// Row quantizes one row of src into dst (same length) and advances to the
+// next row. dst and src may be the same slice.
+func (d *Ditherer) Row(dst, src []uint8) {
+	switch d.method {
+	case DitherFloydSteinberg:
+		d.floydSteinberg(dst, src)
+	case DitherAtkinson:
+		d.atkinson(dst, src)
+	case DitherBayer4:
+		d.ordered(dst, src, bayer4, 4)
+	case DitherBayer8:
+		d.ordered(dst, src, bayer8, 8)
+	case DitherBlueNoise:
+		d.ordered(dst, src, blueNoise, 32)
+	default:
+		for x, v := range src {
+			dst[x] = d.nearest(v)
+		}
+	}
+	d.y++
+}
+
+// ordered compares each pixel's position between two levels against a
+// tiled threshold map of size n x n (thresholds 0-255).
+func (d *Ditherer) ordered(dst, src []uint8, m string, n int) {
+	row := m[(d.y%n)*n : (d.y%n+1)*n]
+	for x, v := range src {
+		l := d.base[v]
+		if d.frac[v] > row[x%n] {
+			l++
+		}
+		dst[x] = l
+	}
+}
+
+// diffuse quantizes gray v plus accumulated error e and returns the level
+// and the remaining error.
+func (d *Ditherer) diffuse(v uint8, e int16) (uint8, int) {
+	g := int(v) + int(e)
+	if g < 0 {
+		g = 0
+	} else if g > 255 {
+		g = 255
+	}
+	l := d.nearest(uint8(g))
+	return l, g - int(d.gray[l])
+}
+
+func (d *Ditherer) floydSteinberg(dst, src []uint8) {
+	cur, next := d.err[0], d.err[1]
+	w := len(src)
+	for i := 0; i < w; i++ {
+		// Serpentine: odd rows run right to left.
+		x, dir := i, 1
+		if d.y&1 == 1 {
+			x, dir = w-1-i, -1
+		}
+		l, e := d.diffuse(src[x], cur[x+2])
+		dst[x] = l
+		cur[x+2+dir] += int16(e * 7 / 16)
+		next[x+2-dir] += int16(e * 3 / 16)
+		next[x+2] += int16(e * 5 / 16)
+		next[x+2+dir] += int16(e / 16)
+	}
+	clear(cur)
+	d.err[0], d.err[1] = next, cur
+}
+
+func (d *Ditherer) atkinson(dst, src []uint8) {
+	cur, next, next2 := d.err[0], d.err[1], d.err[2]
+	for x, v := range src {
+		l, e := d.diffuse(v, cur[x+2])
+		dst[x] = l
+		e /= 8
+		cur[x+3] += int16(e)
+		cur[x+4] += int16(e)
+		next[x+1] += int16(e)
+		next[x+2] += int16(e)
+		next[x+3] += int16(e)
+		next2[x+2] += int16(e)
+	}
+	clear(cur)
+	d.err[0], d.err[1], d.err[2] = next, next2, cur
+}
+
+// Bayer matrices scaled to 0-255 thresholds: (index + 0.5) * 256 / n².
+const bayer4 = "" +
+	"\x08\x88\x28\xa8" +
+	"\xc8\x48\xe8\x68" +
+	"\x38\xb8\x18\x98" +
+	"\xf8\x78\xd8\x58"
+
+const bayer8 = "" +
+	"\x02\x82\x22\xa2\x0a\x8a\x2a\xaa" +
+	"\xc2\x42\xe2\x62\xca\x4a\xea\x6a" +
+	"\x32\xb2\x12\x92\x3a\xba\x1a\x9a" +
+	"\xf2\x72\xd2\x52\xfa\x7a\xda\x5a" +
+	"\x0e\x8e\x2e\xae\x06\x86\x26\xa6" +
+	"\xce\x4e\xee\x6e\xc6\x46\xe6\x66" +
+	"\x3e\xbe\x1e\x9e\x36\xb6\x16\x96" +
+	"\xfe\x7e\xde\x5e\xf6\x76\xd6\x56"
+
+// blueNoise is a 32x32 void-and-cluster threshold map: thresholds spread
+// evenly with no low-frequency structure, so flat areas dither without the
+// cross-hatch pattern of Bayer matrices.
+const blueNoise = "" +
+	"\xf0\x36\x84\x63\xaa\x50\x2f\xb1\x56\x85\xba\x75\x44\xc2\x8a\x3c\xdc\xb7\xcd\x8e\xf9\x4f\xc1\x88\xe1\x73\xae\x66\x53\xd2\x0b\x5d" +
+	"\x23\xc0\xd8\x13\xfe\x76\x02\x9a\xf8\x0e\xde\x18\xe8\x2b\xab\x00\x67\x51\x7e\x08\xaa\x76\x0d\xb3\x59\x41\xf9\x25\xa0\x3b\x91\xaf" +
+	"\x7d\x67\xa2\x43\x8d\xb9\xe0\x3c\x65\xc5\x50\xa3\x83\x5b\xce\xf6\x9e\x29\xe9\x38\xd4\x40\xf1\x2a\x9d\x11\x8c\xc1\xdd\x6e\xfb\x48" +
+	"\xcd\x1a\xef\x2c\x5a\x17\xc8\x7f\x23\x94\x39\x6d\xff\x1e\x47\x79\x18\xc6\xa6\x69\x1f\x96\xc7\x64\xea\xd8\x37\x7c\x15\x2d\xb9\x00" +
+	"\x87\x50\xb1\x94\xe6\x6c\xa0\x4d\xf0\xb5\xdc\x05\xc7\xb4\x95\xe6\x57\x81\x42\xfd\xb8\x51\x83\x01\x79\x4e\xab\x5e\xe8\x98\x58\xe4" +
+	"\x37\xd9\x6f\x06\xce\x3a\x1e\xd4\x0c\x76\x2e\x8e\x3e\x65\x0d\x35\xb2\xda\x05\x8c\x16\xe6\x31\xa7\xcf\x22\xbd\x08\xc8\x40\x75\xa4" +
+	"\xbd\x26\xf7\x48\x83\xa9\xfc\x8d\x60\xac\xec\x59\xa5\xf1\xd1\x73\x23\x9b\x62\xcb\x78\xad\x60\xf8\x41\x98\x66\xff\x8a\x24\xf3\x16" +
+	"\x90\x58\x9f\xc3\x16\x56\x73\x30\xc2\x44\x11\xca\x1b\x7b\x49\xbb\xe8\x3e\xf2\x30\x4a\xdd\x25\x8e\x17\xdc\x80\x32\x51\xa8\xd0\x6b" +
+	"\xef\x0b\x78\x32\xe5\xb5\xd0\x01\xe6\x9d\x6f\x88\xdd\x2a\x9f\x09\x67\x92\x1a\xb6\x9d\x0a\x70\xb5\x57\xc2\x0f\xe2\xb5\x62\x04\x43" +
+	"\xc6\xac\xd5\x64\x89\x27\x46\x95\x5b\x21\xf8\x3a\xae\x56\xfc\x84\xc9\x55\xd9\x7a\x5a\xc8\xe7\x33\xf4\x77\x4a\x95\x1d\xeb\x9c\x84" +
+	"\x55\x1e\x3f\xfa\x0d\xa1\xf3\x7c\xd5\xb7\x50\xc4\x18\x6c\xd1\x20\x35\xac\x02\xfb\x2b\x88\x45\xa1\x03\xad\x2b\xca\x7a\x3c\xd6\x2f" +
+	"\xe8\x81\x96\xb3\x4d\xbf\x66\x13\x36\x8c\x0c\x7a\xea\x97\x44\xbb\xed\x73\x4c\x99\xbe\x10\xd7\x61\x8b\xdd\x6b\xf2\x56\xbb\x15\x6d" +
+	"\xbf\x0f\xe1\x2e\x71\xda\x2a\xea\xaf\x69\xe1\xa2\x34\x07\x7f\x5f\x0f\x8b\xdf\x3d\x6a\xed\x7a\x1f\xbb\x3b\x19\x9b\x07\x87\xfd\xa2" +
+	"\x47\x77\x5b\xcd\x08\x90\x57\x99\x43\xcc\x26\x5c\xd3\xb4\xf6\xa5\xd5\x2c\xb8\x14\xa5\x29\xcc\x48\xfc\x5d\xc3\xe3\x45\xb2\x5e\x22" +
+	"\xd2\xf5\x39\xab\x7d\xff\x1c\xc3\x79\x03\xf9\x8f\x4b\x6e\x21\x3b\x54\x95\x63\xf7\x84\x53\xaf\x91\x0a\xa6\x7b\x26\x71\xc9\x34\x98" +
+	"\x05\xb6\x92\x1b\x49\xb8\x36\xe3\x61\xa6\x32\xb9\x12\xe2\x86\xbf\xe9\x1b\xc7\x45\xd6\x04\xea\x69\x2c\xd7\x4f\x94\xf1\x10\xe0\x82" +
+	"\x6f\x29\x63\xee\xd9\x6a\xa3\x11\x8d\xd6\x52\x74\xc9\x40\x9f\x01\x6b\x7f\xa7\x28\x76\x9e\x38\xb7\x85\xf6\x15\xb0\x39\x63\xa8\x4e" +
+	"\xc4\xe5\x42\x9e\x04\x86\x53\xf5\x40\x1f\xec\xaa\x2a\xfd\x5c\xda\x36\xf6\x0c\xe0\x5d\xc4\x1d\x59\xcb\x41\x6b\xc0\x8a\xda\x1d\xfa" +
+	"\x87\x12\x78\xbc\x31\xce\x25\xb0\xca\x7e\x64\x07\x93\x7d\x16\xb1\x96\x4f\xba\x8e\x3f\xff\x7e\xe3\x0f\x99\x28\xec\x04\x49\x9a\x2f" +
+	"\x55\xad\xd3\x58\x93\xe9\x72\x5f\x0e\x9a\xbe\x46\xd5\x52\xc2\x72\x20\xd1\x65\x2e\xb0\x09\x97\x4c\x70\xad\xd7\x55\x7d\xc9\x6d\xb9" +
+	"\xdf\x3b\x21\xfc\x0c\x45\xa1\xde\x37\xf9\x24\xe7\xaf\x2e\xf2\x3f\xe7\x83\x10\xee\x71\xd3\x27\xc1\xf1\x33\x85\x1b\xa8\x38\xef\x0e" +
+	"\x61\xa4\x88\x6c\xc5\x7c\x1c\xc0\x8b\x54\x70\x86\x0e\x6c\x90\x06\xa8\x4b\xbe\x92\x54\xa7\x41\x8a\x00\x5d\xba\xfd\x66\xd4\x26\x91" +
+	"\xf3\x02\xd7\x4d\xae\x32\xf2\x4c\x00\xb4\xd0\x3a\xa3\xdb\x57\xce\x77\x24\xe2\x34\x1a\xf8\x68\xd9\xa4\xe1\x46\x14\x9a\x4e\xb2\x79" +
+	"\x4b\xbd\x2d\xeb\x18\x89\xd8\x67\xa0\xe5\x17\x5f\xfb\x22\xbc\x38\xf7\x61\xa0\xd4\x82\xb6\x14\x31\x7c\x24\x71\xd0\x89\x08\xe0\x35" +
+	"\x9d\x81\x65\x98\xcf\x5a\xa9\x23\x7b\x30\x92\xbe\x47\x82\x99\x11\xb1\x80\x03\x5a\x44\xcd\x93\x58\xf5\xc5\x9f\x37\xf3\x5f\xc4\x19" +
+	"\xfa\xcc\x10\x42\x75\x06\x3e\xfe\xc8\x4a\xef\x74\x07\xe5\x68\x52\xdf\x2f\xc0\xf0\x75\x0d\xe4\xb0\x47\x09\x5c\xb4\x1c\x7f\xa9\x6e" +
+	"\x54\x27\xac\xf0\xbd\xde\x91\xb2\x0b\x64\x9e\x20\xcf\xa7\x28\xc6\x8f\x49\x9b\x1f\xab\x3a\x68\x22\x81\xdb\x8f\xe4\x51\x2b\xe7\x3c" +
+	"\x94\xde\x60\x87\x34\x1e\x6e\x4f\x86\xdb\x39\xb3\x59\x3f\xeb\x78\x19\xfe\x69\xdb\x8d\xc7\xfb\x9c\xbf\x33\x12\x6f\xbc\x8b\xd1\x13" +
+	"\x7b\xbc\x0a\x4b\xa2\xf7\xb7\x2d\xe9\x1a\xc1\x80\xfa\x8c\x0b\xa3\x3d\xcc\x09\x4d\x2c\x5c\x01\x4a\x72\xee\xaa\x3e\xfe\x02\x62\xa6" +
+	"\x43\x29\xee\x6a\xd3\x14\x5b\xca\xa1\x72\x53\x03\x2d\x6a\xd6\xba\x5e\x85\xb3\x77\xed\xb8\x80\xd2\x17\x5e\xcf\x27\x9c\x4e\xc3\xf5" +
+	"\x70\xcb\xae\x8f\x3d\x7e\x96\x0a\x42\xf4\x93\xe3\xcb\x9c\x4c\x21\xf4\x30\xe2\xa4\x15\x97\x3d\xf4\xa5\x90\x48\x82\xdc\x74\x33\x19" +
+	"\x9b\x52\x05\xe4\x25\xc5\xeb\x6d\xd2\x20\x35\xa9\x60\x12\xed\x74\x97\x13\x46\x62\x28\xd8\x68\x1d\x31\xc6\x06\xec\x1c\xb6\x89\xdf"
@@ -0,0 +1,103 @@
+package epd47
+
+import "testing"
+
+var allDitherMethods = []DitherMethod{DitherNone, DitherFloydSteinberg, DitherAtkinson, DitherBayer4, DitherBayer8, DitherBlueNoise}
+
+// ditherFlat dithers a w x w field of gray g and returns the average level
+// and the set of levels used.
+func ditherFlat(m DitherMethod, w, levels int, g uint8) (float64, map[uint8]bool) {
+	d := NewDitherer(m, w, levels)
+	src := make([]uint8, w)
+	for x := range src {
+		src[x] = g
+	}
+	dst := make([]uint8, w)
+	sum := 0
+	used := map[uint8]bool{}
+	for y := 0; y < w; y++ {
+		d.Row(dst, src)
+		for _, v := range dst {
+			sum += int(v)
+			used[v] = true
+		}
+	}
+	return float64(sum) / float64(w*w), used
+}
+
+func TestDitherPreservesTone(t *testing.T) {
+	for _, m := range allDitherMethods[1:] {
+		// Halfway between levels 7 and 8 averages out to 7.5.
+		avg, used := ditherFlat(m, 64, 16, (LevelToGray(7).Y+LevelToGray(8).Y)/2)
+		if avg < 7.3 || avg > 7.7 {
+			t.Errorf("%v: expected average level near 7.5, got %.2f", m, avg)
+		}
+		if !used[7] || !used[8] {
+			t.Errorf("%v: expected a mix of levels 7 and 8, got %v", m, used)
+		}
+
+		// A quarter gray at 1bpp inks about a quarter of the pixels.
+		// Atkinson drops a quarter of the error, so allow it more slack.
+		avg, _ = ditherFlat(m, 64, 2, 191)
+		lo, hi := 0.22, 0.28
+		if m == DitherAtkinson {
+			lo = 0.15
+		}
+		if avg < lo || avg > hi {
+			t.Errorf("%v: expected about 25%% ink at 1bpp, got %.2f", m, avg)
+		}
+	}
+
+	avg, used := ditherFlat(DitherNone, 16, 2, 191)
+	if avg != 0 || len(used) != 1 {
+		t.Errorf("Expected DitherNone to round a light gray to white, got %.2f %v", avg, used)
+	}
+}
+
+func TestDitherExtremesStayClean(t *testing.T) {
+	for _, m := range allDitherMethods {
+		for _, g := range []uint8{0, 255} {
+			_, used := ditherFlat(m, 32, 16, g)
+			if len(used) != 1 {
+				t.Errorf("%v: expected gray %d to map to a single level, got %v", m, g, used)
+			}
+		}
+	}
+}
+
+func TestDitherResetIsDeterministic(t *testing.T) {
+	src := make([]uint8, 40)
+	for x := range src {
+		src[x] = uint8(x * 6)
+	}
+	for _, m := range allDitherMethods {
+		d := NewDitherer(m, len(src), 16)
+		first := make([][]uint8, 5)
+		for y := range first {
+			first[y] = make([]uint8, len(src))
+			d.Row(first[y], src)
+		}
+		d.Reset()
+		row := make([]uint8, len(src))
+		for y := range first {
+			d.Row(row, src)
+			if string(row) != string(first[y]) {
+				t.Errorf("%v: row %d differs after Reset", m, y)
+			}
+		}
+	}
+}
+
+func TestParseDitherMethod(t *testing.T) {
+	for _, m := range allDitherMethods {
+		if got, ok := ParseDitherMethod(m.String()); !ok || got != m {
+			t.Errorf("Expected %q to parse to %d, got %d %v", m.String(), m, got, ok)
+		}
+	}
+	if m, ok := ParseDitherMethod("fs"); !ok || m != DitherFloydSteinberg {
+		t.Error("Expected fs to parse as Floyd-Steinberg")
+	}
+	if _, ok := ParseDitherMethod("halftone"); ok {
+		t.Error("Expected unknown name to fail")
+	}
+}
@@ -1,6 +1,7 @@
 package epd47
 
 import (
+	"image"
 	"testing"
 	"time"
 )
@@ -381,11 +382,11 @@ func TestSparsePixelBuffer(t *testing.T) {
 	}
 	
 	// Test that false pixels are not stored (memory optimization)
-	if d.pixelBuffer != nil {
+	if d.pending != nil {
 		// Should only have 3 pixels (the true ones)
 		expectedCount := 3
-		if len(d.pixelBuffer) != expectedCount {
-			t.Errorf("Expected %d pixels in buffer, got %d", expectedCount, len(d.pixelBuffer))
+		if len(d.pending) != expectedCount {
+			t.Errorf("Expected %d pixels in buffer, got %d", expectedCount, len(d.pending))
 		}
 	}
 	
@@ -416,12 +417,9 @@ func TestSparsePixelBuffer(t *testing.T) {
 	}
 	
 	// After Display(), buffers should be cleared
-	if d.pixelBuffer != nil && len(d.pixelBuffer) > 0 {
+	if len(d.pending) > 0 {
 		t.Error("Pixel buffer should be cleared after Display()")
 	}
-	if d.grayscaleBuffer != nil && len(d.grayscaleBuffer) > 0 {
-		t.Error("Grayscale buffer should be cleared after Display()")
-	}
 	
 	// Test ClearDisplay()
 	d.SetPixel(100, 100, true)
@@ -439,4 +437,207 @@ func TestSparsePixelBuffer(t *testing.T) {
 	if d.GetGrayscalePixel(101, 101) != 0 {
 		t.Error("Grayscale pixel should be cleared after ClearDisplay()")
 	}
-}
\ No newline at end of file
+}
+
+func TestMixedPixelsRenderInOnePass(t *testing.T) {
+	d := newTestDevice(200, 200)
+	sleeps := 0
+	d.bus.sleepUS = func(us int) { sleeps++ }
+
+	// A 1bpp pixel and a gray pixel on the same row, then a gray pixel
+	// overwritten by a 1bpp one: the last write wins.
+	d.SetPixel(11, 40, true)
+	d.SetGrayscalePixel(20, 40, 6)
+	d.SetGrayscalePixel(30, 40, 9)
+	d.SetPixel(30, 40, true)
+	if got := d.GetGrayscalePixel(11, 40); got != 15 {
+		t.Errorf("Expected a set pixel to read as level 15, got %d", got)
+	}
+	if got := d.GetGrayscalePixel(30, 40); got != 15 {
+		t.Errorf("Expected the later SetPixel to win, got %d", got)
+	}
+	if !d.GetPixel(30, 40) || d.GetPixel(20, 40) {
+		t.Error("Expected GetPixel to threshold levels at 8")
+	}
+
+	if err := d.Display(); err != nil {
+		t.Fatalf("Display() failed: %v", err)
+	}
+	mixed := sleeps
+
+	// One 4bpp pass over the bounding box, from x=11, costs the same.
+	sleeps = 0
+	bm := NewBitmap4bpp(20, 1)
+	d.DrawImage4bpp(11, 40, bm.Width, bm.Height, bm.Pix, BlackOnWhite)
+	if mixed != sleeps {
+		t.Errorf("Expected one 4bpp pass (%d sleeps), got %d", sleeps, mixed)
+	}
+
+	// Only black pixels take the 1bpp fast path.
+	d.SetPixel(11, 40, true)
+	d.SetPixel(30, 40, true)
+	sleeps = 0
+	d.Display()
+	if sleeps >= mixed {
+		t.Errorf("Expected black-only pixels to draw faster than a 4bpp pass, got %d sleeps", sleeps)
+	}
+}
+
+func TestClearArea(t *testing.T) {
+	d := newTestDevice(200, 100)
+	sleeps := 0
+	d.bus.sleepUS = func(us int) { sleeps++ }
+
+	d.ClearArea(image.Rect(300, 0, 400, 50), 1)
+	if sleeps != 0 {
+		t.Errorf("Expected an off-screen area to be skipped, got %d sleeps", sleeps)
+	}
+
+	d.ClearArea(image.Rect(150, 10, 250, 20), 1)
+	clipped := sleeps
+	sleeps = 0
+	d.ClearArea(image.Rect(150, 10, 200, 20), 1)
+	if clipped == 0 || clipped != sleeps {
+		t.Errorf("Expected the area clipped to the panel, got %d and %d sleeps", clipped, sleeps)
+	}
+
+	// Only rows inside the area are driven, so taller areas take longer.
+	sleeps = 0
+	d.ClearArea(image.Rect(150, 10, 200, 30), 1)
+	if sleeps <= clipped {
+		t.Errorf("Expected a taller area to take longer, got %d sleeps", sleeps)
+	}
+}
+
+// pinRecorder keeps the last level written to each named pin and the
+// configuration register bits latched by CFG_STR.
+type pinRecorder struct {
+	level    map[string]bool
+	data     bool
+	shift    []bool
+	latched  []bool
+	released bool
+	acquired int
+	late     []string // pins written after release
+}
+
+func (r *pinRecorder) pin(name string) PinOut {
+	r.level[name] = true // unknown start: assume the worst
+	return func(v bool) {
+		r.level[name] = v
+		if r.released {
+			r.late = append(r.late, name)
+		}
+		switch {
+		case name == "CFG_DATA":
+			r.data = v
+		case name == "CFG_CLK" && v:
+			r.shift = append(r.shift, r.data)
+		case name == "CFG_STR" && v && len(r.shift) >= 8:
+			r.latched = r.shift[len(r.shift)-8:]
+			r.shift = nil
+		}
+	}
+}
+
+func newRecordedDevice() (*Device, *pinRecorder) {
+	r := &pinRecorder{level: map[string]bool{}}
+	cfg := Config{
+		Width: 32, Height: 16,
+		CFG_DATA: r.pin("CFG_DATA"), CFG_CLK: r.pin("CFG_CLK"), CFG_STR: r.pin("CFG_STR"),
+		CKV: r.pin("CKV"), STH: r.pin("STH"), CKH: r.pin("CKH"),
+		D0: r.pin("D0"), D1: r.pin("D1"), D2: r.pin("D2"), D3: r.pin("D3"),
+		D4: r.pin("D4"), D5: r.pin("D5"), D6: r.pin("D6"), D7: r.pin("D7"),
+		ReleasePins: func() { r.released = true },
+		AcquirePins: func() { r.released = false; r.acquired++ },
+	}
+	return New(cfg), r
+}
+
+func TestPowerOffAllParksPins(t *testing.T) {
+	d, r := newRecordedDevice()
+	d.Configure()
+	d.PowerOn()
+	bm := NewBitmap4bpp(32, 2)
+	bm.Fill(15) // leaves the data lines high
+	d.DrawImage4bpp(0, 0, 32, 2, bm.Pix, BlackOnWhite)
+	d.PowerOff()
+	if !r.level["STH"] {
+		t.Fatal("Expected STH left high before PowerOffAll")
+	}
+
+	d.PowerOffAll()
+	for name, v := range r.level {
+		if v {
+			t.Errorf("Expected %s low after PowerOffAll", name)
+		}
+	}
+	if len(r.latched) != 8 {
+		t.Fatal("Expected the configuration register latched")
+	}
+	// Shifted from epOutputEnable down to epLatchEnable.
+	for i, b := range r.latched {
+		if b != (i == 6) {
+			t.Errorf("Expected register bit %d to be %v, got %v", i, i == 6, b)
+		}
+	}
+	if !r.released {
+		t.Error("Expected ReleasePins called")
+	}
+	if len(r.late) != 0 {
+		t.Errorf("Expected no writes after release, got %v", r.late)
+	}
+}
+
+func TestPowerOnAcquiresPins(t *testing.T) {
+	d, r := newRecordedDevice()
+	d.PowerOn()
+	if r.acquired != 0 {
+		t.Error("Expected pins not acquired before a release")
+	}
+	d.PowerOffAll()
+	d.PowerOn() // a wake without a reset
+	if r.acquired != 1 || len(r.late) != 0 {
+		t.Errorf("Expected the pins acquired before use, got %d calls and late writes %v", r.acquired, r.late)
+	}
+	if !r.level["STH"] {
+		t.Error("Expected the panel powered again")
+	}
+	d.PowerOn()
+	if r.acquired != 1 {
+		t.Error("Expected pins acquired once per release")
+	}
+}
+
+func TestPowerOffAllWithoutRelease(t *testing.T) {
+	d, r := newRecordedDevice()
+	d.bus.releasePins = nil
+	d.PowerOn()
+	d.PowerOffAll() // must not panic
+	if r.level["STH"] || r.level["CFG_STR"] {
+		t.Error("Expected lines parked low without a release hook")
+	}
+
+	// Without a way back the pins are not released at all.
+	d, r = newRecordedDevice()
+	d.bus.acquirePins = nil
+	d.PowerOffAll()
+	if r.released {
+		t.Error("Expected ReleasePins skipped without AcquirePins")
+	}
+}
+
+func TestExpandOddX(t *testing.T) {
+	d := newTestDevice(16, 1)
+	v := make([]uint16, 4)
+	// Pixels 3, 4 and 5 get levels A, B and C.
+	d.expand4bppLine([]byte{0xAB, 0xC0}, 3, 3, v)
+	if v[0] != 0x0A00 || v[1] != 0x00BC || v[2] != 0 {
+		t.Errorf("Expected the row shifted by a nibble, got %#04x", v)
+	}
+	// The last column of the panel.
+	d.expand4bppLine([]byte{0xF0}, 15, 1, v)
+	if v[3] != 0x0F00 {
+		t.Errorf("Expected the last pixel set, got %#04x", v[3])
+	}
+}
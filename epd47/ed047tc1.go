@@ -45,7 +45,15 @@ func (d *Device) pulseCKV(highUS, lowUS int) {
 }
 
 // Power sequences
+// PowerOn does nothing once a LowPowerShutdown guard has halted the panel.
 func (d *Device) PowerOn() {
+	if d.halted {
+		return
+	}
+	if d.bus.released {
+		d.bus.acquirePins()
+		d.bus.released = false
+	}
 	d.cfg.epScanDirection = true
 	d.cfg.powerDisable = false
 	d.pushCfg()
@@ -81,9 +89,37 @@ func (d *Device) PowerOff() {
 	d.pushCfg()
 }
 
+// parkBus drives the gate clock, source control and data lines low so
+// nothing is sourced into the unpowered panel.
+func (d *Device) parkBus() {
+	d.bus.ckv(false)
+	d.bus.sth(false)
+	d.bus.ckh(false)
+	for i, p := range d.bus.dataPins {
+		if d.dataMask&(1<<uint(i)) != 0 {
+			p(false)
+		}
+	}
+}
+
+// PowerOffAll is the shutdown path: it latches an all-off configuration
+// register (power disabled, every other bit low, as after New), drives every
+// control, data and shift-register line low, and then calls
+// Config.ReleasePins, if set, to turn the pins into high-impedance inputs.
+// The register outputs hold their value with CFG_STR low. For an orderly
+// rail shutdown call PowerOff first. The next PowerOn calls
+// Config.AcquirePins to make released pins outputs again.
 func (d *Device) PowerOffAll() {
-	d.cfg = reg{} // all false
+	d.cfg = reg{powerDisable: true} // everything else off
 	d.pushCfg()
+	d.parkBus()
+	d.bus.cfgStr(false)
+	d.bus.cfgClk(false)
+	d.bus.cfgData(false)
+	if d.bus.releasePins != nil && d.bus.acquirePins != nil {
+		d.bus.releasePins()
+		d.bus.released = true
+	}
 }
 
 // Frame control
@@ -1,5 +1,7 @@
 package epd47
 
+import "image"
+
 // DrawMode like C
 type DrawMode uint8
 
@@ -13,6 +15,12 @@ const (
 var contrast4 = [Frames4bpp]int{30, 30, 20, 20, 30, 30, 30, 40, 40, 50, 50, 50, 100, 200, 300}
 var contrast4White = [Frames4bpp]int{10, 10, 8, 8, 8, 8, 8, 10, 10, 15, 15, 20, 20, 100, 300}
 
+// Frames and pulse width per phase of a ClearArea cycle.
+const (
+	clearFrames  = 4
+	clearPulseUS = 50
+)
+
 func (d *Device) resetLUT(mode DrawMode) {
 	fill := byte(0x55)
 	if mode == WhiteOnBlack || mode == WhiteOnWhite {
@@ -67,9 +75,18 @@ func (d *Device) expand4bppLine(src []byte, x, w int, v []uint16) {
 	full := d.line4b[:d.w/2]
 	// clear only the part needed at edges - use clear() for better performance
 	clear(full)
-	// place src at x/2
+	// place src at x/2, a nibble further right for an odd x
 	start := x / 2
-	copy(full[start:start+len(src)], src)
+	if x&1 == 0 {
+		copy(full[start:start+len(src)], src)
+	} else {
+		for i, b := range src {
+			full[start+i] |= b >> 4
+			if start+i+1 < len(full) {
+				full[start+i+1] = b << 4
+			}
+		}
+	}
 
 	// Build v from pairs of bytes
 	vi := 0
@@ -84,54 +101,27 @@ func (d *Device) expand4bppLine(src []byte, x, w int, v []uint16) {
 
 // Public 4bpp draw: 15-frame pipeline.
 func (d *Device) DrawImage4bpp(x, y, w, h int, data []byte, mode DrawMode) {
-	if w <= 0 || h <= 0 {
-		return
-	}
-	if x < 0 || y < 0 || x+w > d.w || y+h > d.h {
-		// add full clipping later
-		return
-	}
-
-	lut := contrast4[:]
-	if mode == WhiteOnBlack {
-		lut = contrast4White[:]
-	}
-	srcStride := (w / 2) + (w % 2)
-
-	// v buffer: 4 uint16 per 2 bytes across full width/2 -> (Width/2)/2 = Width/4 entries
-	var v [MaxWidth / 4]uint16
-	outLen := d.w / 2
-
-	d.resetLUT(mode)
-	for k := 0; k < Frames4bpp; k++ {
-		d.updateLUT(uint8(k), mode)
-		d.StartFrame()
-		for row := 0; row < d.h; row++ {
-			if row < y || row >= y+h {
-				d.SkipRow()
-				continue
-			}
-			sr := data[(row-y)*srcStride : (row-y+1)*srcStride]
-			d.expand4bppLine(sr, x, w, v[:d.w/4])
-			d.calcEPDInput4bpp(v[:d.w/4], outLen)
-			d.latchRow()
-			d.pulseCKV(lut[k], 50)
-			d.writeLineBytes(d.line4b[:outLen])
-			d.pulseCKV(1, 1)
-		}
-		d.EndFrame()
-		// small settle
-		d.bus.sleepUS(5_000)
-	}
+	d.DrawRows4bpp(x, y, w, h, packedRows{data, (w / 2) + (w % 2)}, mode)
 }
 
 // 1bpp helpers
 
 // Clear flashes the full screen dark/white for cycles.
+// A failing power guard may skip it (see PowerGuard).
 func (d *Device) Clear(cycles int) {
+	d.clearScreen(cycles)
+}
+
+// clearScreen is Clear, returning ErrLowPower when it was skipped.
+func (d *Device) clearScreen(cycles int) error {
 	if cycles <= 0 {
 		cycles = 2
 	}
+	if _, err := d.powerGate(); err != nil {
+		return err
+	}
+	d.recordClear(d.Bounds())
+	d.ghost = Ghosting{}
 	n := d.w / 8
 	for c := 0; c < cycles; c++ {
 		// dark - use clear() for zeroing
@@ -150,41 +140,58 @@ func (d *Device) Clear(cycles int) {
 		}
 		d.EndFrame()
 	}
+	return nil
 }
 
-// Draw1bpp draws a packed MSB-first 1bpp image at x,y.
-func (d *Device) Draw1bpp(x, y, w, h int, src []byte, pulseUS int) {
-	if w <= 0 || h <= 0 {
+// ClearArea flashes the pixels inside r dark and back to white for cycles
+// (2 if cycles <= 0), leaving the rest of the panel untouched. Drawing only
+// adds ink, so a region is cleared this way before it is redrawn in a
+// partial update.
+func (d *Device) ClearArea(r image.Rectangle, cycles int) {
+	r = r.Intersect(d.Bounds())
+	if r.Empty() {
 		return
 	}
-	if x < 0 || y < 0 || x+w > d.w || y+h > d.h {
+	if cycles <= 0 {
+		cycles = 2
+	}
+	if _, err := d.powerGate(); err != nil {
 		return
 	}
-	srcStride := (w + 7) / 8
-	dstStride := d.w / 8
+	d.recordClear(r)
+	d.ghost.AreaClears++
+	for c := 0; c < cycles; c++ {
+		d.pushArea(r, 0x55) // darken
+		d.pushArea(r, 0xAA) // lighten
+	}
+}
 
-	d.StartFrame()
-	for row := 0; row < d.h; row++ {
-		if row < y || row >= y+h {
-			d.SkipRow()
-			continue
-		}
-		// zero line - use clear() for better performance
-		clear(d.line1b[:dstStride])
-		// blit row bits into position
-		sr := src[(row-y)*srcStride : (row-y+1)*srcStride]
-		for col := 0; col < w; col++ {
-			sbyte := sr[col>>3]
-			sbit := 7 - (col & 7)
-			on := (sbyte>>sbit)&1 == 1
-			if on {
-				dbitpos := x + col
-				dbyte := dbitpos >> 3
-				dbit := 7 - (dbitpos & 7)
-				d.line1b[dbyte] |= (1 << dbit)
+// pushArea drives every pixel of r with the 2-bit action repeated in code
+// for clearFrames frames; pixels outside r get 00 (no change).
+func (d *Device) pushArea(r image.Rectangle, code byte) {
+	line := d.line4b[:d.w/4]
+	clear(line)
+	for x := r.Min.X; x < r.Max.X; x++ {
+		s := 2 * uint(x&3)
+		line[x>>2] |= code & (3 << s)
+	}
+	for f := 0; f < clearFrames; f++ {
+		d.StartFrame()
+		for row := 0; row < d.h; row++ {
+			if row < r.Min.Y || row >= r.Max.Y {
+				d.SkipRow()
+				continue
 			}
+			d.latchRow()
+			d.pulseCKV(clearPulseUS, 50)
+			d.writeLineBytes(line)
+			d.pulseCKV(1, 1)
 		}
-		d.outputRow1bpp(dstStride, pulseUS)
+		d.EndFrame()
 	}
-	d.EndFrame()
+}
+
+// Draw1bpp draws a packed MSB-first 1bpp image at x,y.
+func (d *Device) Draw1bpp(x, y, w, h int, src []byte, pulseUS int) {
+	d.DrawRows1bpp(x, y, w, h, packedRows{src, (w + 7) / 8}, pulseUS)
 }
@@ -0,0 +1,146 @@
+package epd47
+
+import (
+	"image"
+	"image/color"
+)
+
+// Gray4Model quantizes colors to the 16 gray levels the panel can show.
+// Converted colors are color.Gray values from LevelToGray.
+var Gray4Model color.Model = color.ModelFunc(func(c color.Color) color.Color {
+	return LevelToGray(GrayToLevel(grayOf(c)))
+})
+
+// GrayToLevel maps an 8-bit gray value (0 black, 255 white) to the nearest
+// 4bpp ink level (0 white, 15 black).
+func GrayToLevel(y uint8) uint8 {
+	return uint8((int(255-y)*15 + 127) / 255)
+}
+
+// LevelToGray maps a 4bpp ink level back to its 8-bit gray value.
+func LevelToGray(level uint8) color.Gray {
+	if level > 15 {
+		level = 15
+	}
+	return color.Gray{Y: 255 - level*17}
+}
+
+// grayOf returns the luminance of c composited over white paper, so
+// transparent pixels leave the panel blank instead of turning black.
+func grayOf(c color.Color) uint8 {
+	if g, ok := c.(color.Gray); ok {
+		return g.Y
+	}
+	r, g, b, a := c.RGBA()
+	// Same weights as color.GrayModel, on premultiplied values.
+	y := (19595*r + 38470*g + 7471*b + 1<<15) >> 16
+	y += 0xffff - a
+	return uint8(y >> 8)
+}
+
+// ColorModel implements image.Image.
+func (b *Bitmap1bpp) ColorModel() color.Model { return color.GrayModel }
+
+// Bounds implements image.Image.
+func (b *Bitmap1bpp) Bounds() image.Rectangle { return image.Rect(0, 0, b.Width, b.Height) }
+
+// At implements image.Image: inked pixels are black, others white.
+func (b *Bitmap1bpp) At(x, y int) color.Color {
+	if b.Get(x, y) {
+		return color.Gray{Y: 0}
+	}
+	return color.Gray{Y: 255}
+}
+
+// ColorModel implements draw.Image.
+func (b *Bitmap4bpp) ColorModel() color.Model { return Gray4Model }
+
+// Bounds implements draw.Image.
+func (b *Bitmap4bpp) Bounds() image.Rectangle { return image.Rect(0, 0, b.Width, b.Height) }
+
+// At implements draw.Image.
+func (b *Bitmap4bpp) At(x, y int) color.Color { return LevelToGray(b.Level(x, y)) }
+
+// Set implements draw.Image, quantizing c to the nearest level.
+func (b *Bitmap4bpp) Set(x, y int, c color.Color) { b.SetLevel(x, y, GrayToLevel(grayOf(c))) }
+
+// ColorModel implements draw.Image for the pending pixel buffer.
+func (d *Device) ColorModel() color.Model { return Gray4Model }
+
+// Bounds implements draw.Image.
+func (d *Device) Bounds() image.Rectangle { return image.Rect(0, 0, d.w, d.h) }
+
+// At implements draw.Image, reading the pending grayscale pixel buffer.
+func (d *Device) At(x, y int) color.Color {
+	l := d.GetGrayscalePixel(int16(x), int16(y))
+	if d.cal != nil {
+		return color.Gray{Y: d.cal[l&15]}
+	}
+	return LevelToGray(l)
+}
+
+// Set implements draw.Image by queueing a grayscale pixel; call Display()
+// to push queued pixels. Prefer DrawImage for whole images, which streams
+// them to the panel instead of buffering every pixel.
+func (d *Device) Set(x, y int, c color.Color) {
+	if x < 0 || y < 0 || x >= d.w || y >= d.h {
+		return
+	}
+	d.SetGrayscalePixel(int16(x), int16(y), d.grayToLevel(grayOf(c)))
+}
+
+// ImageOptions controls how DrawImage converts and pushes an image.
+type ImageOptions struct {
+	Mode   DrawMode
+	Dither DitherMethod // DitherNone picks the nearest level
+}
+
+// DrawImage converts img to 4bpp through the device calibration and draws
+// it with its bounds' minimum point at at, clipped to the panel. A nil opts
+// draws BlackOnWhite without dithering.
+//
+// Rows are converted as they are drawn, so only one row is buffered; the
+// price is converting the image again on each of the 15 frames.
+func (d *Device) DrawImage(img image.Image, at image.Point, opts *ImageOptions) {
+	var o ImageOptions
+	if opts != nil {
+		o = *opts
+	}
+	b := img.Bounds()
+	dst := b.Add(at.Sub(b.Min)).Intersect(d.Bounds())
+	if dst.Empty() {
+		return
+	}
+	src := &imageRows{
+		img: img,
+		at:  dst.Min.Sub(at).Add(b.Min), // img coordinate of dst.Min
+		dd:  d.newDitherer(o.Dither, dst.Dx(), 16),
+		row: make([]uint8, dst.Dx()),
+	}
+	d.DrawRows4bpp(dst.Min.X, dst.Min.Y, dst.Dx(), dst.Dy(), src, o.Mode)
+}
+
+// imageRows converts and dithers an image one row at a time.
+type imageRows struct {
+	img image.Image
+	at  image.Point // image coordinate of row 0, column 0
+	dd  *Ditherer
+	row []uint8
+}
+
+// ReadRow implements RowSource. The ditherer restarts at row 0, so every
+// frame gets the same levels.
+func (r *imageRows) ReadRow(y int, dst []byte) error {
+	if y == 0 {
+		r.dd.Reset()
+	}
+	for x := range r.row {
+		r.row[x] = grayOf(r.img.At(r.at.X+x, r.at.Y+y))
+	}
+	r.dd.Row(r.row, r.row)
+	clear(dst)
+	for x, v := range r.row {
+		dst[x>>1] |= v << (4 - 4*uint(x&1))
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package epd47
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+)
+
+func TestGrayLevelMapping(t *testing.T) {
+	if GrayToLevel(255) != 0 || GrayToLevel(0) != 15 {
+		t.Errorf("Expected white->0 and black->15, got %d and %d", GrayToLevel(255), GrayToLevel(0))
+	}
+	for l := uint8(0); l < 16; l++ {
+		if got := GrayToLevel(LevelToGray(l).Y); got != l {
+			t.Errorf("Level %d round-tripped to %d", l, got)
+		}
+	}
+
+	c := Gray4Model.Convert(color.RGBA{R: 200, G: 200, B: 200, A: 255}).(color.Gray)
+	if c.Y != LevelToGray(GrayToLevel(200)).Y {
+		t.Errorf("Gray4Model did not quantize, got %d", c.Y)
+	}
+
+	// Transparent pixels composite onto white paper.
+	if grayOf(color.RGBA{}) != 255 {
+		t.Errorf("Expected transparent to be white, got %d", grayOf(color.RGBA{}))
+	}
+}
+
+func TestBitmapDrawImage(t *testing.T) {
+	src := image.NewGray(image.Rect(0, 0, 4, 1))
+	src.Pix = []uint8{255, 170, 85, 0}
+
+	b := NewBitmap4bpp(4, 1)
+	draw.Draw(b, b.Bounds(), src, image.Point{}, draw.Src)
+	want := []uint8{0, 5, 10, 15}
+	for x, w := range want {
+		if b.Level(x, 0) != w {
+			t.Errorf("Pixel %d: expected level %d, got %d", x, w, b.Level(x, 0))
+		}
+	}
+	if b.At(3, 0).(color.Gray).Y != 0 {
+		t.Error("Expected At to report black for level 15")
+	}
+
+	m := NewBitmap1bpp(2, 1)
+	m.Set(1, 0, true)
+	if m.At(0, 0).(color.Gray).Y != 255 || m.At(1, 0).(color.Gray).Y != 0 {
+		t.Error("Unexpected 1bpp At colors")
+	}
+}
+
+func TestDeviceDrawImage(t *testing.T) {
+	d := newTestDevice(100, 100)
+
+	// Device is a draw.Image over its pending grayscale buffer.
+	d.Set(5, 6, color.Gray{Y: 0})
+	if d.GetGrayscalePixel(5, 6) != 15 {
+		t.Errorf("Expected pending level 15, got %d", d.GetGrayscalePixel(5, 6))
+	}
+	if d.At(5, 6).(color.Gray).Y != 0 {
+		t.Error("Expected At to read the pending pixel")
+	}
+	d.Set(-1, 200, color.Black) // ignored
+
+	img := image.NewGray(image.Rect(10, 10, 60, 40))
+	for i := range img.Pix {
+		img.Pix[i] = uint8(i)
+	}
+	// Should not panic, including odd positions, clipping and nil options.
+	d.DrawImage(img, image.Pt(3, 7), nil)
+	d.DrawImage(img, image.Pt(80, 80), &ImageOptions{Dither: DitherFloydSteinberg})
+	d.DrawImage(img, image.Pt(-20, -20), &ImageOptions{Mode: WhiteOnBlack})
+	d.DrawImage(img, image.Pt(500, 500), nil)
+}
+
+func TestImageRowsRepeatEachFrame(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 7, 5))
+	for i := range img.Pix {
+		img.Pix[i] = uint8(i * 7)
+	}
+	d := newTestDevice(100, 100)
+	src := &imageRows{img: img, dd: d.newDitherer(DitherFloydSteinberg, 7, 16), row: make([]uint8, 7)}
+
+	// Reference: the whole image dithered in one pass.
+	dd := d.newDitherer(DitherFloydSteinberg, 7, 16)
+	want := NewBitmap4bpp(7, 5)
+	row := make([]uint8, 7)
+	for y := 0; y < 5; y++ {
+		copy(row, img.Pix[y*img.Stride:])
+		dd.Row(row, row)
+		for x, v := range row {
+			want.SetLevel(x, y, v)
+		}
+	}
+
+	got := make([]byte, want.Stride)
+	for frame := 0; frame < 2; frame++ {
+		for y := 0; y < 5; y++ {
+			src.ReadRow(y, got)
+			if string(got) != string(want.Pix[y*want.Stride:(y+1)*want.Stride]) {
+				t.Fatalf("Frame %d row %d: expected %x, got %x", frame, y, want.Pix[y*want.Stride:(y+1)*want.Stride], got)
+			}
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package epd47
 
+import "image/draw"
+
 // Displayer defines the interface for e-paper display operations.
 // This follows TinyGo driver patterns for display interfaces.
 type Displayer interface {
@@ -35,4 +37,6 @@ type GrayscaleDisplayer interface {
 
 // Ensure Device implements the interfaces
 var _ Displayer = (*Device)(nil)
-var _ GrayscaleDisplayer = (*Device)(nil)
\ No newline at end of file
+var _ GrayscaleDisplayer = (*Device)(nil)
+var _ draw.Image = (*Device)(nil)
+var _ draw.Image = (*Bitmap4bpp)(nil)
\ No newline at end of file
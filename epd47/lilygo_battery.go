@@ -0,0 +1,144 @@
+package epd47
+
+import "errors"
+
+// LilyGoBatteryPin is the ADC pin wired to the battery through the
+// board's 1:2 divider (GPIO14 on the ESP32-S3 T5 4.7").
+const LilyGoBatteryPin = 14
+
+// BatteryConfig describes how a battery voltage is measured. Only ReadADC
+// is required; the rest defaults to the LilyGo T5 4.7" values.
+type BatteryConfig struct {
+	// ReadADC returns one raw reading of the battery pin, 0..ADCMax.
+	ReadADC func() uint16
+	// ADCMax is the full-scale reading; 0 means 65535, TinyGo's scale.
+	ADCMax uint16
+	// VRef is the pin voltage at full scale; 0 means 3.3 V.
+	VRef float32
+	// Divider is the battery voltage per volt at the pin; 0 means 2.
+	Divider float32
+
+	// Two-point calibration against a meter: the battery voltage is
+	// v*Scale + Offset. A zero Scale means 1.
+	Scale, Offset float32
+
+	// Curve maps voltage to charge; nil means LiPoCurve.
+	Curve []VoltPercent
+	// Samples is the number of readings averaged per Sample; 0 means 1.
+	Samples int
+	// Smoothing is the weight of a new sample in the running average,
+	// 0..1; 0 and 1 disable smoothing.
+	Smoothing float32
+}
+
+// VoltPercent is a point of a state-of-charge curve.
+type VoltPercent struct {
+	Volts   float32
+	Percent int
+}
+
+// LiPoCurve is a typical single-cell LiPo discharge curve at light load,
+// highest voltage first.
+var LiPoCurve = []VoltPercent{
+	{4.20, 100}, {4.15, 95}, {4.11, 90}, {4.08, 85}, {4.02, 80},
+	{3.98, 75}, {3.95, 70}, {3.91, 65}, {3.87, 60}, {3.85, 55},
+	{3.84, 50}, {3.82, 45}, {3.80, 40}, {3.79, 35}, {3.77, 30},
+	{3.75, 25}, {3.73, 20}, {3.71, 15}, {3.69, 10}, {3.61, 5},
+	{3.27, 0},
+}
+
+// BatteryMonitor turns ADC readings into a smoothed battery voltage and
+// charge. It does no timing of its own: call Sample at the rate wanted,
+// for example once per display update.
+type BatteryMonitor struct {
+	cfg   BatteryConfig
+	volts float32
+	valid bool
+}
+
+// ErrNoADC is returned for a BatteryConfig without ReadADC.
+var ErrNoADC = errors.New("epd47: battery monitor needs ReadADC")
+
+// NewBatteryMonitor returns a monitor for cfg, filling in defaults.
+func NewBatteryMonitor(cfg BatteryConfig) (*BatteryMonitor, error) {
+	if cfg.ReadADC == nil {
+		return nil, ErrNoADC
+	}
+	if cfg.ADCMax == 0 {
+		cfg.ADCMax = 65535
+	}
+	if cfg.VRef == 0 {
+		cfg.VRef = 3.3
+	}
+	if cfg.Divider == 0 {
+		cfg.Divider = 2
+	}
+	if cfg.Scale == 0 {
+		cfg.Scale = 1
+	}
+	if cfg.Curve == nil {
+		cfg.Curve = LiPoCurve
+	}
+	cfg.Samples = max(cfg.Samples, 1)
+	return &BatteryMonitor{cfg: cfg}, nil
+}
+
+// NewLilyGoBattery returns a monitor for the T5 4.7" battery pin read by
+// read, averaging a few readings to tame the ESP32 ADC noise.
+func NewLilyGoBattery(read func() uint16) (*BatteryMonitor, error) {
+	return NewBatteryMonitor(BatteryConfig{ReadADC: read, Samples: 8, Smoothing: 0.3})
+}
+
+// Sample takes a measurement, folds it into the running average and
+// returns the smoothed battery voltage.
+func (m *BatteryMonitor) Sample() float32 {
+	var sum uint32
+	for i := 0; i < m.cfg.Samples; i++ {
+		sum += uint32(m.cfg.ReadADC())
+	}
+	raw := float32(sum) / float32(m.cfg.Samples)
+	v := raw/float32(m.cfg.ADCMax)*m.cfg.VRef*m.cfg.Divider*m.cfg.Scale + m.cfg.Offset
+
+	a := m.cfg.Smoothing
+	if !m.valid || a <= 0 || a >= 1 {
+		m.volts = v
+	} else {
+		m.volts += a * (v - m.volts)
+	}
+	m.valid = true
+	return m.volts
+}
+
+// Voltage returns the smoothed battery voltage, sampling first if
+// Sample has not been called.
+func (m *BatteryMonitor) Voltage() float32 {
+	if !m.valid {
+		return m.Sample()
+	}
+	return m.volts
+}
+
+// Percent returns the state of charge of Voltage on the curve, 0..100,
+// interpolating between points. Like Voltage it does not sample again;
+// call Sample first for a fresh reading.
+func (m *BatteryMonitor) Percent() int {
+	return curvePercent(m.cfg.Curve, m.Voltage())
+}
+
+// curvePercent interpolates v on c, which is sorted by falling voltage.
+func curvePercent(c []VoltPercent, v float32) int {
+	if len(c) == 0 {
+		return 0
+	}
+	if v >= c[0].Volts {
+		return c[0].Percent
+	}
+	for i := 1; i < len(c); i++ {
+		hi, lo := c[i-1], c[i]
+		if v >= lo.Volts {
+			f := (v - lo.Volts) / (hi.Volts - lo.Volts)
+			return lo.Percent + int(f*float32(hi.Percent-lo.Percent)+0.5)
+		}
+	}
+	return c[len(c)-1].Percent
+}
@@ -0,0 +1,76 @@
+package epd47
+
+import (
+	"math"
+	"testing"
+)
+
+// fakeADC returns the reading for battery volts on the T5 defaults.
+func fakeADC(volts *float32) func() uint16 {
+	return func() uint16 { return uint16(*volts / 2 / 3.3 * 65535) }
+}
+
+func near(a, b float32) bool { return math.Abs(float64(a-b)) < 0.01 }
+
+func TestBatteryVoltage(t *testing.T) {
+	v := float32(3.9)
+	m, _ := NewBatteryMonitor(BatteryConfig{ReadADC: fakeADC(&v)})
+	if got := m.Voltage(); !near(got, 3.9) {
+		t.Errorf("Expected 3.9V, got %v", got)
+	}
+
+	// A meter read 4.0V where the ADC said 3.9V.
+	c, _ := NewBatteryMonitor(BatteryConfig{ReadADC: fakeADC(&v), Offset: 0.1})
+	if got := c.Sample(); !near(got, 4.0) {
+		t.Errorf("Expected the calibrated 4.0V, got %v", got)
+	}
+	c, _ = NewBatteryMonitor(BatteryConfig{ReadADC: fakeADC(&v), Scale: 1.1})
+	if got := c.Sample(); !near(got, 4.29) {
+		t.Errorf("Expected the scaled 4.29V, got %v", got)
+	}
+
+	if _, err := NewBatteryMonitor(BatteryConfig{}); err != ErrNoADC {
+		t.Errorf("Expected ErrNoADC, got %v", err)
+	}
+}
+
+func TestBatteryPercent(t *testing.T) {
+	v := float32(0)
+	m, _ := NewBatteryMonitor(BatteryConfig{ReadADC: fakeADC(&v)})
+	for _, c := range []struct {
+		volts float32
+		want  int
+	}{
+		{4.3, 100}, {4.2, 100}, {3.84, 50}, {3.865, 59}, {3.27, 0}, {3.0, 0},
+	} {
+		v = c.volts
+		m.Sample()
+		if got := m.Percent(); got != c.want {
+			t.Errorf("Expected %d%% at %vV, got %d", c.want, c.volts, got)
+		}
+	}
+}
+
+func TestBatterySmoothing(t *testing.T) {
+	v := float32(4.0)
+	reads := 0
+	read := fakeADC(&v)
+	m, _ := NewLilyGoBattery(func() uint16 { reads++; return read() })
+	m.Sample()
+	if reads != 8 {
+		t.Errorf("Expected 8 readings per sample, got %d", reads)
+	}
+
+	// A sudden sag under load only moves the average part of the way.
+	v = 3.6
+	got := m.Sample()
+	if !near(got, 3.88) {
+		t.Errorf("Expected a smoothed 3.88V, got %v", got)
+	}
+	for i := 0; i < 30; i++ {
+		got = m.Sample()
+	}
+	if !near(got, 3.6) {
+		t.Errorf("Expected the average to settle at 3.6V, got %v", got)
+	}
+}
@@ -0,0 +1,63 @@
+package epd47
+
+import "io"
+
+// Board lifecycle around the ESP32-S3 deep-sleep cycle. The panel keeps
+// its image without power, so only a cold boot needs to clear it:
+//
+//	if wokeFromTimer {
+//		err = display.WarmWake(bytes.NewReader(saved)) // no flash
+//	} else {
+//		display.ColdBoot()
+//	}
+//	// ... partial updates ...
+//	display.PrepareSleep(&buf) // then store buf and enter deep sleep
+//
+// These use the device's SleepUS for every delay, so they build and test
+// without TinyGo.
+
+// Settling time after power-on before the first frame and after a clear.
+const (
+	powerOnSettleUS = 200_000
+	clearSettleUS   = 100_000
+)
+
+// ColdBoot powers the panel and clears it, for the first start after a
+// power cycle or reset when nothing is known about the screen.
+func (d *LilyGoT547) ColdBoot() {
+	d.PowerOn()
+	d.bus.sleepUS(powerOnSettleUS)
+	d.Clear(2)
+	d.bus.sleepUS(clearSettleUS)
+}
+
+// WarmWake powers the panel after a deep sleep without clearing it, so the
+// image stays and partial updates continue. A non-nil state written by
+// PrepareSleep is restored with RestoreState; if that fails the panel is
+// cleared as on a cold boot and the error returned.
+func (d *LilyGoT547) WarmWake(state io.Reader) error {
+	d.PowerOn()
+	d.bus.sleepUS(powerOnSettleUS)
+	if state == nil {
+		return nil
+	}
+	if err := d.RestoreState(state); err != nil {
+		d.Clear(2)
+		d.bus.sleepUS(clearSettleUS)
+		return err
+	}
+	return nil
+}
+
+// PrepareSleep saves the display state to a non-nil state writer when
+// readback is on, turns the panel rails off in order and parks every line
+// as PowerOffAll does. Call it last before entering deep sleep.
+func (d *LilyGoT547) PrepareSleep(state io.Writer) error {
+	var err error
+	if state != nil && d.Displayed() != nil {
+		err = d.SaveState(state)
+	}
+	d.PowerOff()
+	d.PowerOffAll()
+	return err
+}
@@ -0,0 +1,82 @@
+package epd47
+
+import (
+	"bytes"
+	"testing"
+)
+
+func newTestBoard() (*LilyGoT547, *int) {
+	d := newTestDevice(32, 16)
+	slept := new(int)
+	d.bus.sleepUS = func(us int) { *slept += us }
+	return &LilyGoT547{Device: d}, slept
+}
+
+func TestColdBootClears(t *testing.T) {
+	b, slept := newTestBoard()
+	b.SetReadback(true)
+	b.Displayed().Fill(7)
+	b.ghost.Draws = 9
+	b.ColdBoot()
+	if b.Displayed().Level(3, 3) != 0 || b.Ghosting() != (Ghosting{}) {
+		t.Error("Expected a cold boot to clear the screen")
+	}
+	if b.cfg.powerDisable || !b.cfg.posPowerEnable || !b.cfg.negPowerEnable {
+		t.Error("Expected the rails on")
+	}
+	if *slept < powerOnSettleUS+clearSettleUS {
+		t.Errorf("Expected the settle delays through SleepUS, slept %dus", *slept)
+	}
+}
+
+func TestSleepWakeCycle(t *testing.T) {
+	b, _ := newTestBoard()
+	b.SetReadback(true)
+	b.ColdBoot()
+	bm := NewBitmap4bpp(4, 2)
+	bm.Fill(12)
+	b.DrawImage4bpp(2, 2, 4, 2, bm.Pix, BlackOnWhite)
+
+	var state bytes.Buffer
+	if err := b.PrepareSleep(&state); err != nil {
+		t.Fatal(err)
+	}
+	if !b.cfg.powerDisable || b.cfg.posPowerEnable || b.cfg.negPowerEnable {
+		t.Error("Expected the rails off before sleep")
+	}
+
+	// After the wake the device is new, and must not clear.
+	w, _ := newTestBoard()
+	cleared := false
+	w.bus.ckv = func(bool) { cleared = true } // any frame clocks CKV
+	if err := w.WarmWake(bytes.NewReader(state.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+	if cleared {
+		t.Error("Expected a warm wake to leave the screen alone")
+	}
+	if w.Displayed() == nil || w.Displayed().Level(3, 3) != 12 || w.Ghosting().Draws != 1 {
+		t.Error("Expected the saved state restored")
+	}
+
+	// A bad state falls back to a cold boot.
+	w2, _ := newTestBoard()
+	w2.SetReadback(true)
+	w2.Displayed().Fill(5)
+	if err := w2.WarmWake(bytes.NewReader([]byte("junk"))); err != ErrState {
+		t.Errorf("Expected ErrState, got %v", err)
+	}
+	if w2.Displayed().Level(0, 0) != 0 {
+		t.Error("Expected the panel cleared after a bad state")
+	}
+
+	// Without readback there is nothing to save, and no state to restore.
+	n, _ := newTestBoard()
+	state.Reset()
+	if err := n.PrepareSleep(&state); err != nil || state.Len() != 0 {
+		t.Errorf("Expected nothing saved without readback, got %d bytes (%v)", state.Len(), err)
+	}
+	if err := n.WarmWake(nil); err != nil {
+		t.Error(err)
+	}
+}
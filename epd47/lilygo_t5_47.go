@@ -16,8 +16,10 @@ type LilyGoT547 struct {
 // NewLilyGoT547 creates a new EPD47 device preconfigured for the LilyGo T5 4.7" ESP32-S3 board.
 // All pins are automatically configured according to the board's hardware layout.
 func NewLilyGoT547() *LilyGoT547 {
-	// Pin adapter function
+	// Pin adapter function; it remembers each pin for ReleasePins and AcquirePins.
+	var pins []machine.Pin
 	pinOut := func(p machine.Pin) PinOut {
+		pins = append(pins, p)
 		p.Configure(machine.PinConfig{Mode: machine.PinOutput})
 		return func(level bool) {
 			if level {
@@ -63,6 +65,20 @@ func NewLilyGoT547() *LilyGoT547 {
 		D7: pinOut(machine.Pin(7)),
 
 		SleepUS: sleepUS,
+
+		// Shutdown leaves the pins as pulled-down inputs, so the config
+		// register strobe cannot float and latch noise in sleep; PowerOn
+		// takes them back.
+		ReleasePins: func() {
+			for _, p := range pins {
+				p.Configure(machine.PinConfig{Mode: machine.PinInputPulldown})
+			}
+		},
+		AcquirePins: func() {
+			for _, p := range pins {
+				p.Configure(machine.PinConfig{Mode: machine.PinOutput})
+			}
+		},
 	}
 
 	device := New(cfg)
@@ -71,62 +87,20 @@ func NewLilyGoT547() *LilyGoT547 {
 }
 
 // Initialize performs the complete initialization sequence for the display.
-// This includes power-on and initial clear operations.
+// This includes power-on and initial clear operations; it is ColdBoot.
+// After a deep-sleep wake use WarmWake instead to keep the screen.
 func (d *LilyGoT547) Initialize() {
-	// Power on the display
-	d.PowerOn()
-	time.Sleep(200 * time.Millisecond)
-
-	// Clear the display to ensure clean state
-	d.Clear(2)
-	time.Sleep(100 * time.Millisecond)
+	d.ColdBoot()
 }
 
-// Shutdown performs a complete shutdown of the display.
+// Shutdown performs a complete shutdown of the display: the rails off in
+// order, then every line parked and the pins released as inputs.
 // This should be called before the device goes to sleep or powers off.
 func (d *LilyGoT547) Shutdown() {
+	d.PowerOff()
 	d.PowerOffAll()
 }
 
-// DrawText draws a simple text-like pattern for demonstration.
-// This is a basic implementation - for real text rendering, use a font library.
-func (d *LilyGoT547) DrawText(x, y int, text string, charWidth, charHeight int) {
-	if len(text) == 0 {
-		return
-	}
-
-	totalWidth := len(text) * (charWidth + 2) // 2px spacing between chars
-	totalHeight := charHeight
-	
-	// Create a simple pattern for each character
-	data := make([]byte, (totalWidth+7)/8*totalHeight)
-	// Note: make() already zeros the slice, so no need to clear()
-	
-	for i, char := range text {
-		charX := i * (charWidth + 2)
-		
-		// Create a simple pattern based on the character
-		// This is just a demo - real text would use font data
-		pattern := int(char) % 8
-		
-		for r := 0; r < charHeight; r++ {
-			for c := 0; c < charWidth; c++ {
-				// Simple pattern generation
-				if (r+c+pattern)%3 == 0 {
-					bitX := charX + c
-					byteIdx := r*((totalWidth+7)/8) + (bitX >> 3)
-					bit := 7 - (bitX & 7)
-					if byteIdx < len(data) {
-						data[byteIdx] |= (1 << bit)
-					}
-				}
-			}
-		}
-	}
-	
-	d.Draw1bpp(x, y, totalWidth, totalHeight, data, 10)
-}
-
 // DrawRectangle draws a rectangle outline in 1bpp mode.
 func (d *LilyGoT547) DrawRectangle(x, y, w, h int, filled bool) {
 	if w <= 0 || h <= 0 {
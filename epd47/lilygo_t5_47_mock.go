@@ -73,20 +73,17 @@ func NewLilyGoT547() *LilyGoT547 {
 }
 
 // Initialize performs the complete initialization sequence for the display.
-// This includes power-on and initial clear operations.
+// This includes power-on and initial clear operations; it is ColdBoot.
+// After a deep-sleep wake use WarmWake instead to keep the screen.
 func (d *LilyGoT547) Initialize() {
-	// Power on the display
-	d.PowerOn()
-	time.Sleep(200 * time.Millisecond)
-
-	// Clear the display to ensure clean state
-	d.Clear(2)
-	time.Sleep(100 * time.Millisecond)
+	d.ColdBoot()
 }
 
-// Shutdown performs a complete shutdown of the display.
+// Shutdown performs a complete shutdown of the display: the rails off in
+// order, then every line parked and the pins released as inputs.
 // This should be called before the device goes to sleep or powers off.
 func (d *LilyGoT547) Shutdown() {
+	d.PowerOff()
 	d.PowerOffAll()
 }
 
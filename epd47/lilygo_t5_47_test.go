@@ -54,7 +54,7 @@ func TestLilyGoT547DrawingMethods(t *testing.T) {
 	display.DrawRectangle(0, 0, 50, 50, true)
 	display.DrawGradient(0, 0, 100, 100, true, BlackOnWhite)
 	display.DrawGradient(0, 0, 100, 100, false, BlackOnWhite)
-	display.DrawText(0, 0, "Test", 8, 16)
+	display.DrawText(0, 0, "Test", nil)
 }
 
 func TestLilyGoT547PowerManagement(t *testing.T) {
@@ -72,7 +72,7 @@ func TestLilyGoT547BoundaryConditions(t *testing.T) {
 	display.DrawCheckerboard(0, 0, 0, 0, 10)
 	display.DrawRectangle(0, 0, -10, -10, false)
 	display.DrawGradient(0, 0, 0, 100, true, BlackOnWhite)
-	display.DrawText(0, 0, "", 8, 16)
+	display.DrawText(0, 0, "", nil)
 	
 	// Test with very large dimensions (should be clipped)
 	display.DrawCheckerboard(0, 0, 2000, 1000, 10)
@@ -0,0 +1,141 @@
+package epd47
+
+import (
+	"errors"
+
+	"github.com/abaschen/tinygo-epd47-s3/font"
+)
+
+// ErrLowPower is returned by draws skipped because the power check failed.
+var ErrLowPower = errors.New("epd47: power too low to update the panel")
+
+// LowPowerPolicy is what a device does with updates while its power check
+// fails. Driving the high-voltage rails from a sagging LiPo gives
+// incomplete refreshes and can brown the board out.
+type LowPowerPolicy uint8
+
+const (
+	// LowPowerDowngrade draws 4bpp BlackOnWhite images as one 1bpp pass,
+	// levels 8 and above black, instead of 15 frames. Clears and 1bpp draws
+	// run as usual; the white modes are deferred.
+	LowPowerDowngrade LowPowerPolicy = iota
+	// LowPowerDefer skips every draw and clear. Callers check PowerOK and
+	// repeat the update once the supply recovers.
+	LowPowerDefer
+	// LowPowerShutdown draws a final screen on the first update that
+	// fails the check, turns the rails off and refuses PowerOn and every
+	// update from then on.
+	LowPowerShutdown
+)
+
+// PowerGuard checks the supply before each panel update.
+type PowerGuard struct {
+	// OK reports whether the supply can drive the panel, for example
+	// VoltageAbove(battery.Sample, 3.5).
+	OK     func() bool
+	Policy LowPowerPolicy
+	// Final draws the last screen for LowPowerShutdown on a powered
+	// panel; nil selects LowBatteryScreen.
+	Final func(d *Device)
+}
+
+// lowPowerPulseUS is the pulse of downgraded 1bpp draws, as for Clear.
+const lowPowerPulseUS = 10
+
+// SetPowerGuard installs g, or removes the guard for nil. Installing a
+// guard also lifts the lock left by LowPowerShutdown, for example after
+// the battery was charged.
+func (d *Device) SetPowerGuard(g *PowerGuard) {
+	d.guard = g
+	d.halted = false
+}
+
+// PowerOK reports whether updates may run at full quality: there is no
+// guard or its check passes.
+func (d *Device) PowerOK() bool {
+	return !d.halted && (d.guard == nil || d.guard.OK())
+}
+
+// Halted reports whether LowPowerShutdown has shown its final screen and
+// locked the panel.
+func (d *Device) Halted() bool { return d.halted }
+
+// VoltageAbove returns a power check that passes while volts reads at
+// least limit. BatteryMonitor.Sample is a suitable volts.
+func VoltageAbove(volts func() float32, limit float32) func() bool {
+	return func() bool { return volts() >= limit }
+}
+
+// LowBatteryScreen clears the panel and centers "Low battery" on it; it is
+// the default final screen of LowPowerShutdown.
+func LowBatteryScreen(d *Device) {
+	d.Clear(2)
+	f := font.DejaVuSans24
+	text := "Low battery"
+	w, h := f.Measure(text, 0)
+	d.DrawText((d.w-w)/2, (d.h-h)/2, text, f)
+}
+
+// powerGate applies the guard before an update. It reports whether a 4bpp
+// draw should be downgraded, or ErrLowPower when the update must not run.
+// The final screen of LowPowerShutdown passes unchecked.
+func (d *Device) powerGate() (downgrade bool, err error) {
+	switch {
+	case d.halted:
+		return false, ErrLowPower
+	case d.guard == nil || d.halting || d.guard.OK():
+		return false, nil
+	}
+	switch d.guard.Policy {
+	case LowPowerDowngrade:
+		return true, nil
+	case LowPowerShutdown:
+		d.halt()
+	}
+	return false, ErrLowPower
+}
+
+// halt draws the final screen, turns the rails off and locks the panel.
+func (d *Device) halt() {
+	d.halting = true
+	final := d.guard.Final
+	if final == nil {
+		final = LowBatteryScreen
+	}
+	final(d)
+	d.PowerOff()
+	d.halting = false
+	d.halted = true
+}
+
+// thresholdRows serves a 4bpp source as 1bpp rows with levels 8 and above
+// set, reading each row into buf.
+type thresholdRows struct {
+	src RowSource
+	buf []byte
+	w   int
+}
+
+func (t thresholdRows) ReadRow(y int, dst []byte) error {
+	if err := t.src.ReadRow(y, t.buf); err != nil {
+		return err
+	}
+	clear(dst)
+	for i := 0; i < t.w; i++ {
+		if t.buf[i>>1]>>(4-4*uint(i&1))&0x0F >= 8 {
+			dst[i>>3] |= 0x80 >> uint(i&7)
+		}
+	}
+	return nil
+}
+
+// drawDowngraded draws a 4bpp source as one 1bpp pass. Only BlackOnWhite
+// can be drawn this way, since a 1bpp pass only adds ink.
+func (d *Device) drawDowngraded(x, y, w, h int, src RowSource, mode DrawMode) error {
+	if mode != BlackOnWhite {
+		return ErrLowPower
+	}
+	// The 1bpp path does not use the 4bpp line buffer.
+	buf := d.line4b[:(w+1)/2]
+	return d.drawRows1bpp(x, y, w, h, thresholdRows{src, buf, w}, lowPowerPulseUS)
+}
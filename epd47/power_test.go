@@ -0,0 +1,166 @@
+package epd47
+
+import (
+	"image"
+	"testing"
+)
+
+// newGuardedDevice returns a powered device with readback and a guard whose
+// check reports *ok.
+func newGuardedDevice(p LowPowerPolicy) (*Device, *bool) {
+	d := newTestDevice(200, 60)
+	d.SetReadback(true)
+	d.PowerOn()
+	ok := new(bool)
+	d.SetPowerGuard(&PowerGuard{OK: func() bool { return *ok }, Policy: p})
+	return d, ok
+}
+
+func TestLowPowerDefer(t *testing.T) {
+	d, ok := newGuardedDevice(LowPowerDefer)
+	bm := NewBitmap4bpp(4, 2)
+	bm.Fill(9)
+	if err := d.DrawRows4bpp(10, 10, 4, 2, bm, BlackOnWhite); err != ErrLowPower {
+		t.Errorf("Expected ErrLowPower, got %v", err)
+	}
+	d.DrawImage4bpp(10, 10, 4, 2, bm.Pix, BlackOnWhite)
+	if d.Displayed().Level(10, 10) != 0 || d.Ghosting().Draws != 0 {
+		t.Error("Expected the draws deferred")
+	}
+	if d.PowerOK() {
+		t.Error("Expected PowerOK to report the failing check")
+	}
+
+	*ok = true
+	d.DrawImage4bpp(10, 10, 4, 2, bm.Pix, BlackOnWhite)
+	*ok = false
+	d.Clear(2)
+	if d.Displayed().Level(10, 10) != 9 {
+		t.Error("Expected the draw to run and the clear to be deferred")
+	}
+}
+
+func TestLowPowerDeferKeepsPixels(t *testing.T) {
+	d, ok := newGuardedDevice(LowPowerDefer)
+	d.SetGrayscalePixel(5, 5, 9)
+	if err := d.Display(); err != ErrLowPower {
+		t.Errorf("Expected ErrLowPower, got %v", err)
+	}
+	if d.GetGrayscalePixel(5, 5) != 9 {
+		t.Error("Expected the deferred pixels kept")
+	}
+	if err := d.ClearDisplay(); err != ErrLowPower || d.GetGrayscalePixel(5, 5) != 9 {
+		t.Errorf("Expected the clear deferred with the pixels kept, got %v", err)
+	}
+
+	*ok = true
+	if err := d.Display(); err != nil {
+		t.Fatal(err)
+	}
+	if d.Displayed().Level(5, 5) != 9 || d.GetGrayscalePixel(5, 5) != 0 {
+		t.Error("Expected the pixels drawn once the supply recovers")
+	}
+}
+
+func TestLowPowerDowngrade(t *testing.T) {
+	d, _ := newGuardedDevice(LowPowerDowngrade)
+	bm := NewBitmap4bpp(4, 1)
+	bm.SetLevel(0, 0, 4)
+	bm.SetLevel(1, 0, 12)
+	bm.SetLevel(3, 0, 8)
+	d.DrawImage4bpp(20, 5, 4, 1, bm.Pix, BlackOnWhite)
+	for x, want := range []uint8{0, 15, 0, 15} {
+		if got := d.Displayed().Level(20+x, 5); got != want {
+			t.Errorf("Expected level %d at x=%d, got %d", want, x, got)
+		}
+	}
+	if d.Ghosting().Draws != 1 {
+		t.Errorf("Expected one 1bpp pass, got %d draws", d.Ghosting().Draws)
+	}
+
+	// The guard is consulted once per update, not again by the 1bpp pass.
+	checks := 0
+	d.guard.OK = func() bool { checks++; return false }
+	d.DrawImage4bpp(20, 5, 4, 1, bm.Pix, BlackOnWhite)
+	if checks != 1 {
+		t.Errorf("Expected one power check per draw, got %d", checks)
+	}
+	if err := d.DrawRows4bpp(20, 5, 4, 1, bm, WhiteOnBlack); err != ErrLowPower {
+		t.Errorf("Expected white draws deferred, got %v", err)
+	}
+}
+
+func TestLowPowerShutdown(t *testing.T) {
+	d, _ := newGuardedDevice(LowPowerShutdown)
+	bm := NewBitmap4bpp(4, 2)
+	bm.Fill(15)
+	d.DrawImage4bpp(0, 0, 4, 2, bm.Pix, BlackOnWhite)
+	if !d.Halted() {
+		t.Fatal("Expected the device halted")
+	}
+	if d.Displayed().Level(0, 0) != 0 {
+		t.Error("Expected the final screen cleared first")
+	}
+	if n := countInk(d.Displayed(), image.Rect(30, 10, 170, 50)); n == 0 {
+		t.Error("Expected the low battery message in the middle")
+	}
+	if !d.cfg.powerDisable || d.cfg.posPowerEnable || d.cfg.negPowerEnable {
+		t.Error("Expected the rails off after the final screen")
+	}
+
+	d.PowerOn()
+	if d.cfg.posPowerEnable {
+		t.Error("Expected PowerOn refused")
+	}
+	before := *d.Displayed()
+	before.Pix = append([]byte(nil), before.Pix...)
+	d.Clear(2)
+	if err := d.DrawRows4bpp(0, 0, 4, 2, bm, BlackOnWhite); err != ErrLowPower {
+		t.Errorf("Expected ErrLowPower after the halt, got %v", err)
+	}
+	if string(before.Pix) != string(d.Displayed().Pix) {
+		t.Error("Expected the final screen to stay")
+	}
+
+	// A custom final screen, and a new guard lifts the lock.
+	finals := 0
+	d.SetPowerGuard(&PowerGuard{
+		OK:     func() bool { return false },
+		Policy: LowPowerShutdown,
+		Final:  func(*Device) { finals++ },
+	})
+	d.PowerOn()
+	if !d.cfg.posPowerEnable {
+		t.Error("Expected a new guard to allow PowerOn")
+	}
+	d.Clear(2)
+	d.Clear(2)
+	if finals != 1 {
+		t.Errorf("Expected the final screen drawn once, got %d", finals)
+	}
+}
+
+func TestVoltageAbove(t *testing.T) {
+	v := float32(3.6)
+	ok := VoltageAbove(func() float32 { return v }, 3.5)
+	if !ok() {
+		t.Error("Expected 3.6V to pass")
+	}
+	v = 3.4
+	if ok() {
+		t.Error("Expected 3.4V to fail")
+	}
+}
+
+// countInk counts pixels of b inside r above level 0.
+func countInk(b *Bitmap4bpp, r image.Rectangle) int {
+	n := 0
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			if b.Level(x, y) != 0 {
+				n++
+			}
+		}
+	}
+	return n
+}
@@ -0,0 +1,128 @@
+package epd47
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"strconv"
+)
+
+// ErrNoReadback is returned by exports when readback is off.
+var ErrNoReadback = errors.New("epd47: readback is off")
+
+// SetReadback turns on (or off, freeing it) a model of what the panel
+// shows, updated by every draw and clear. It costs a full 4bpp frame of
+// RAM (about 253 KB at 960x540), so it is off by default. Turning it on
+// assumes a blank panel; call it before the first Clear.
+func (d *Device) SetReadback(on bool) {
+	switch {
+	case !on:
+		d.shown = nil
+	case d.shown == nil:
+		d.shown = NewBitmap4bpp(d.w, d.h)
+	}
+}
+
+// Displayed returns the levels the panel is believed to show, or nil when
+// readback is off. The bitmap is the device's own and changes with later
+// draws; copy it to keep a snapshot.
+//
+// The model follows the drivers' ink semantics: BlackOnWhite draws and set
+// 1bpp bits darken pixels to at least their level, the white modes lighten
+// them by it, and clears return them to 0. Ghosting and temperature make
+// the real panel differ slightly.
+func (d *Device) Displayed() *Bitmap4bpp { return d.shown }
+
+// Snapshot returns a copy of the displayed image in 8-bit gray through the
+// device calibration, or nil when readback is off. Encode it with
+// image/png for a PNG; the driver does not import image/png itself so that
+// firmware without exports does not link it.
+func (d *Device) Snapshot() *image.Gray {
+	if d.shown == nil {
+		return nil
+	}
+	g := image.NewGray(image.Rect(0, 0, d.w, d.h))
+	for y := 0; y < d.h; y++ {
+		for x := 0; x < d.w; x++ {
+			g.Pix[y*g.Stride+x] = d.levelGray(d.shown.Level(x, y)).Y
+		}
+	}
+	return g
+}
+
+// levelGray returns the gray of level through the calibration.
+func (d *Device) levelGray(l uint8) color.Gray {
+	if d.cal != nil {
+		return color.Gray{Y: d.cal[l&15]}
+	}
+	return LevelToGray(l)
+}
+
+// WritePGM writes the displayed image as a binary PGM (P5) with maxval
+// 255, gray values as in Snapshot. It returns ErrNoReadback when readback
+// is off.
+func (d *Device) WritePGM(w io.Writer) error {
+	if d.shown == nil {
+		return ErrNoReadback
+	}
+	hdr := "P5\n" + strconv.Itoa(d.w) + " " + strconv.Itoa(d.h) + "\n255\n"
+	if _, err := io.WriteString(w, hdr); err != nil {
+		return err
+	}
+	row := make([]byte, d.w)
+	for y := 0; y < d.h; y++ {
+		for x := range row {
+			row[x] = d.levelGray(d.shown.Level(x, y)).Y
+		}
+		if _, err := w.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordRow4bpp applies packed 4bpp row src, drawn at x,y in mode, to the
+// readback model.
+func (d *Device) recordRow4bpp(x, y, w int, src []byte, mode DrawMode) {
+	if d.shown == nil {
+		return
+	}
+	for i := 0; i < w; i++ {
+		l := src[i>>1] >> (4 - 4*uint(i&1)) & 0x0F
+		if l == 0 {
+			continue
+		}
+		old := d.shown.Level(x+i, y)
+		if mode == BlackOnWhite {
+			d.shown.SetLevel(x+i, y, max(old, l))
+		} else {
+			d.shown.SetLevel(x+i, y, old-min(old, l))
+		}
+	}
+}
+
+// recordRow1bpp applies packed 1bpp row src, drawn at x,y, to the readback
+// model: set bits turn black.
+func (d *Device) recordRow1bpp(x, y, w int, src []byte) {
+	if d.shown == nil {
+		return
+	}
+	for i := 0; i < w; i++ {
+		if src[i>>3]&(0x80>>uint(i&7)) != 0 {
+			d.shown.SetLevel(x+i, y, 15)
+		}
+	}
+}
+
+// recordClear blanks r in the readback model.
+func (d *Device) recordClear(r image.Rectangle) {
+	if d.shown == nil {
+		return
+	}
+	if r == d.Bounds() {
+		clear(d.shown.Pix)
+		return
+	}
+	d.shown.FillRect(r, 0)
+}
@@ -0,0 +1,84 @@
+package epd47
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"testing"
+)
+
+func TestReadback(t *testing.T) {
+	d := newTestDevice(16, 8)
+	if d.Displayed() != nil || d.Snapshot() != nil || d.WritePGM(&bytes.Buffer{}) != ErrNoReadback {
+		t.Fatal("Expected readback to be off by default")
+	}
+	d.SetReadback(true)
+
+	// Pending pixels survive Display() in the readback model.
+	d.SetGrayscalePixel(3, 1, 6)
+	d.SetPixel(4, 1, true)
+	d.Display()
+	if d.GetGrayscalePixel(3, 1) != 0 {
+		t.Error("Expected the pending buffer to be empty after Display")
+	}
+	shown := d.Displayed()
+	if shown.Level(3, 1) != 6 || shown.Level(4, 1) != 15 || shown.Level(5, 1) != 0 {
+		t.Errorf("Expected levels 6 and 15, got %d and %d", shown.Level(3, 1), shown.Level(4, 1))
+	}
+
+	// BlackOnWhite darkens, white modes lighten, 1bpp sets black.
+	bm := NewBitmap4bpp(4, 1)
+	bm.Fill(9)
+	d.DrawImage4bpp(2, 1, 4, 1, bm.Pix, BlackOnWhite)
+	if shown.Level(2, 1) != 9 || shown.Level(3, 1) != 9 || shown.Level(4, 1) != 15 {
+		t.Error("Expected BlackOnWhite to darken to at least the drawn level")
+	}
+	bm.Fill(4)
+	d.DrawImage4bpp(2, 1, 4, 1, bm.Pix, WhiteOnBlack)
+	if shown.Level(2, 1) != 5 || shown.Level(4, 1) != 11 {
+		t.Errorf("Expected the white mode to lighten by 4, got %d", shown.Level(2, 1))
+	}
+	b1 := NewBitmap1bpp(8, 2)
+	b1.Set(7, 1, true)
+	d.Draw1bpp(8, 4, 8, 2, b1.Pix, 10)
+	if shown.Level(15, 5) != 15 || shown.Level(14, 5) != 0 {
+		t.Error("Expected the 1bpp pixel at 15,5")
+	}
+
+	// Exports.
+	g := d.Snapshot()
+	if g.GrayAt(15, 5).Y != 0 || g.GrayAt(0, 0).Y != 255 || g.GrayAt(2, 1).Y != LevelToGray(5).Y {
+		t.Error("Unexpected snapshot grays")
+	}
+	var pgm bytes.Buffer
+	if err := d.WritePGM(&pgm); err != nil {
+		t.Fatal(err)
+	}
+	hdr := "P5\n16 8\n255\n"
+	if !bytes.HasPrefix(pgm.Bytes(), []byte(hdr)) || pgm.Len() != len(hdr)+16*8 || pgm.Bytes()[len(hdr)+5*16+15] != 0 {
+		t.Errorf("Unexpected PGM of %d bytes", pgm.Len())
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, g); err != nil {
+		t.Fatal(err)
+	}
+	if img, err := png.Decode(&buf); err != nil || img.Bounds() != d.Bounds() {
+		t.Errorf("Expected a PNG round trip, got %v", err)
+	}
+
+	// Clears.
+	d.ClearArea(image.Rect(14, 4, 16, 6), 1)
+	if shown.Level(15, 5) != 0 || shown.Level(4, 1) != 11 {
+		t.Error("Expected ClearArea to blank only its area")
+	}
+	d.Clear(1)
+	for _, b := range shown.Pix {
+		if b != 0 {
+			t.Fatal("Expected Clear to blank the model")
+		}
+	}
+	d.SetReadback(false)
+	if d.Displayed() != nil {
+		t.Error("Expected readback off")
+	}
+}
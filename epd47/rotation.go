@@ -0,0 +1,66 @@
+package epd47
+
+import "image"
+
+// Rotation turns logical drawing coordinates clockwise onto the panel.
+// With Rotate90 or Rotate270 a 960x540 panel is used as a 540x960 portrait
+// display.
+type Rotation uint8
+
+const (
+	Rotate0 Rotation = iota
+	Rotate90
+	Rotate180
+	Rotate270
+)
+
+// Size returns the logical size of a w x h panel.
+func (r Rotation) Size(w, h int) (int, int) {
+	if r&1 == 1 {
+		return h, w
+	}
+	return w, h
+}
+
+// Inverse returns the rotation that undoes r.
+func (r Rotation) Inverse() Rotation { return (4 - r&3) & 3 }
+
+// Point maps logical point p onto a w x h panel. Inverse().Point with the
+// logical size maps panel points back, for example touch coordinates.
+func (r Rotation) Point(p image.Point, w, h int) image.Point {
+	switch r & 3 {
+	case Rotate90:
+		return image.Pt(w-1-p.Y, p.X)
+	case Rotate180:
+		return image.Pt(w-1-p.X, h-1-p.Y)
+	case Rotate270:
+		return image.Pt(p.Y, h-1-p.X)
+	}
+	return p
+}
+
+// Rect maps logical rectangle rc onto a w x h panel.
+func (r Rotation) Rect(rc image.Rectangle, w, h int) image.Rectangle {
+	if rc.Empty() {
+		return image.Rectangle{}
+	}
+	a := r.Point(rc.Min, w, h)
+	b := r.Point(rc.Max.Sub(image.Pt(1, 1)), w, h)
+	return image.Rect(min(a.X, b.X), min(a.Y, b.Y), max(a.X, b.X)+1, max(a.Y, b.Y)+1)
+}
+
+// Rotate returns a copy of b turned by r, so that pixel p of b lands on
+// r.Point(p) of the result.
+func (b *Bitmap4bpp) Rotate(r Rotation) *Bitmap4bpp {
+	w, h := r.Size(b.Width, b.Height)
+	out := NewBitmap4bpp(w, h)
+	for y := 0; y < b.Height; y++ {
+		for x := 0; x < b.Width; x++ {
+			if l := b.Level(x, y); l != 0 {
+				p := r.Point(image.Pt(x, y), w, h)
+				out.SetLevel(p.X, p.Y, l)
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,40 @@
+package epd47
+
+import (
+	"image"
+	"testing"
+)
+
+func TestRotation(t *testing.T) {
+	// Logical corners of a portrait 540x960 view on the 960x540 panel.
+	p := Rotate90.Point(image.Pt(0, 0), 960, 540)
+	if p != image.Pt(959, 0) {
+		t.Errorf("Expected the top-left corner at the top right, got %v", p)
+	}
+	for r := Rotate0; r <= Rotate270; r++ {
+		lw, lh := r.Size(960, 540)
+		for _, q := range []image.Point{{0, 0}, {3, 7}, {lw - 1, lh - 1}} {
+			p := r.Point(q, 960, 540)
+			if !p.In(image.Rect(0, 0, 960, 540)) {
+				t.Errorf("%d: %v mapped off the panel to %v", r, q, p)
+			}
+			if back := r.Inverse().Point(p, lw, lh); back != q {
+				t.Errorf("%d: expected %v back, got %v", r, q, back)
+			}
+		}
+		rc := r.Rect(image.Rect(10, 20, 40, 30), 960, 540)
+		if rc.Dx()*rc.Dy() != 300 {
+			t.Errorf("%d: expected a 30x10 area, got %v", r, rc)
+		}
+	}
+	if rc := Rotate270.Rect(image.Rect(0, 0, 10, 4), 960, 540); rc != image.Rect(0, 530, 4, 540) {
+		t.Errorf("Unexpected rotated rect %v", rc)
+	}
+
+	b := NewBitmap4bpp(3, 2)
+	b.SetLevel(2, 0, 15)
+	rb := b.Rotate(Rotate90)
+	if rb.Width != 2 || rb.Height != 3 || rb.Level(1, 2) != 15 {
+		t.Errorf("Unexpected rotated bitmap %dx%d", rb.Width, rb.Height)
+	}
+}
@@ -0,0 +1,184 @@
+package epd47
+
+import (
+	"errors"
+
+	"github.com/abaschen/tinygo-epd47-s3/asset"
+)
+
+// RowSource supplies packed image rows on demand, so images can be
+// decompressed, decoded or generated while they are drawn instead of being
+// held in RAM.
+//
+// ReadRow fills dst with row y (0 is the top row of the image) in the same
+// packed layout Draw1bpp or DrawImage4bpp take; dst is exactly one row
+// long. DrawRows4bpp reads every row once per frame, 15 times in order from
+// 0, so a stateful source (a decompressor or a Ditherer) should restart
+// when asked for row 0.
+type RowSource interface {
+	ReadRow(y int, dst []byte) error
+}
+
+// RowFunc adapts a function to RowSource.
+type RowFunc func(y int, dst []byte) error
+
+// ReadRow implements RowSource.
+func (f RowFunc) ReadRow(y int, dst []byte) error { return f(y, dst) }
+
+// ErrShortData is returned when packed image data ends before a row.
+var ErrShortData = errors.New("epd47: image data shorter than its size")
+
+// packedRows serves rows from a packed buffer in memory.
+type packedRows struct {
+	data   []byte
+	stride int
+}
+
+func (p packedRows) ReadRow(y int, dst []byte) error {
+	i := y * p.stride
+	if i+len(dst) > len(p.data) {
+		return ErrShortData
+	}
+	copy(dst, p.data[i:])
+	return nil
+}
+
+// ReadRow implements RowSource.
+func (b *Bitmap1bpp) ReadRow(y int, dst []byte) error {
+	return packedRows{b.Pix, b.Stride}.ReadRow(y, dst)
+}
+
+// ReadRow implements RowSource.
+func (b *Bitmap4bpp) ReadRow(y int, dst []byte) error {
+	return packedRows{b.Pix, b.Stride}.ReadRow(y, dst)
+}
+
+// DrawRows4bpp is DrawImage4bpp with rows pulled from src on every frame;
+// only one row is buffered. If src fails, the current
+// frame is finished without the remaining rows and the error returned.
+// A failing power guard downgrades or skips the draw (see PowerGuard).
+func (d *Device) DrawRows4bpp(x, y, w, h int, src RowSource, mode DrawMode) error {
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+	if x < 0 || y < 0 || x+w > d.w || y+h > d.h {
+		// add full clipping later
+		return nil
+	}
+	if downgrade, err := d.powerGate(); err != nil {
+		return err
+	} else if downgrade {
+		return d.drawDowngraded(x, y, w, h, src, mode)
+	}
+
+	d.ghost.Draws++
+
+	lut := contrast4[:]
+	if mode == WhiteOnBlack {
+		lut = contrast4White[:]
+	}
+	sr := d.row[:(w/2)+(w%2)]
+
+	// v buffer: 4 uint16 per 2 bytes across full width/2 -> (Width/2)/2 = Width/4 entries
+	var v [MaxWidth / 4]uint16
+	outLen := d.w / 2
+
+	d.resetLUT(mode)
+	for k := 0; k < Frames4bpp; k++ {
+		d.updateLUT(uint8(k), mode)
+		d.StartFrame()
+		for row := 0; row < d.h; row++ {
+			if row < y || row >= y+h {
+				d.SkipRow()
+				continue
+			}
+			if err := src.ReadRow(row-y, sr); err != nil {
+				d.finishFrame(row)
+				return err
+			}
+			if k == 0 {
+				d.recordRow4bpp(x, row, w, sr, mode)
+			}
+			d.expand4bppLine(sr, x, w, v[:d.w/4])
+			d.calcEPDInput4bpp(v[:d.w/4], outLen)
+			d.latchRow()
+			d.pulseCKV(lut[k], 50)
+			d.writeLineBytes(d.line4b[:outLen])
+			d.pulseCKV(1, 1)
+		}
+		d.EndFrame()
+		// small settle
+		d.bus.sleepUS(5_000)
+	}
+	return nil
+}
+
+// DrawRows1bpp is Draw1bpp with rows pulled from src; only one row is
+// buffered. If src fails, the frame is finished without the remaining rows
+// and the error returned. A failing power guard may skip the draw.
+func (d *Device) DrawRows1bpp(x, y, w, h int, src RowSource, pulseUS int) error {
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+	if x < 0 || y < 0 || x+w > d.w || y+h > d.h {
+		return nil
+	}
+	if _, err := d.powerGate(); err != nil {
+		return err
+	}
+	return d.drawRows1bpp(x, y, w, h, src, pulseUS)
+}
+
+// drawRows1bpp is DrawRows1bpp on a clipped area without the power guard,
+// which the downgraded 4bpp draws have already consulted.
+func (d *Device) drawRows1bpp(x, y, w, h int, src RowSource, pulseUS int) error {
+	sr := d.row[:(w+7)/8]
+	dstStride := d.w / 8
+	d.ghost.Draws++
+
+	d.StartFrame()
+	for row := 0; row < d.h; row++ {
+		if row < y || row >= y+h {
+			d.SkipRow()
+			continue
+		}
+		if err := src.ReadRow(row-y, sr); err != nil {
+			d.finishFrame(row)
+			return err
+		}
+		d.recordRow1bpp(x, row, w, sr)
+		// zero line - use clear() for better performance
+		clear(d.line1b[:dstStride])
+		// blit row bits into position
+		for col := 0; col < w; col++ {
+			sbyte := sr[col>>3]
+			sbit := 7 - (col & 7)
+			on := (sbyte>>sbit)&1 == 1
+			if on {
+				dbitpos := x + col
+				dbyte := dbitpos >> 3
+				dbit := 7 - (dbitpos & 7)
+				d.line1b[dbyte] |= (1 << dbit)
+			}
+		}
+		d.outputRow1bpp(dstStride, pulseUS)
+	}
+	d.EndFrame()
+	return nil
+}
+
+// DrawAsset streams a compressed asset through the 4bpp pipeline with its
+// top-left corner at x,y. For 1bpp assets DrawRows1bpp with
+// a.Rows1bpp() is faster.
+func (d *Device) DrawAsset(x, y int, a *asset.Decoder, mode DrawMode) error {
+	return d.DrawRows4bpp(x, y, a.Width(), a.Height(), a.Rows4bpp(), mode)
+}
+
+// finishFrame skips the rows from row to the bottom and ends the frame, so
+// an aborted draw leaves the gate driver at a frame boundary.
+func (d *Device) finishFrame(row int) {
+	for ; row < d.h; row++ {
+		d.SkipRow()
+	}
+	d.EndFrame()
+}
@@ -0,0 +1,114 @@
+package epd47
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/abaschen/tinygo-epd47-s3/asset"
+)
+
+func TestDrawRows4bppPullsEveryFrame(t *testing.T) {
+	d := newTestDevice(100, 100)
+	calls := map[int]int{}
+	last := -1
+	err := d.DrawRows4bpp(10, 20, 30, 5, RowFunc(func(y int, dst []byte) error {
+		if len(dst) != 15 {
+			t.Fatalf("Expected a 15-byte row, got %d", len(dst))
+		}
+		if y != (last+1)%5 {
+			t.Fatalf("Expected rows in order, got %d after %d", y, last)
+		}
+		last = y
+		calls[y]++
+		fillBuffer(dst, 0xF0)
+		return nil
+	}), BlackOnWhite)
+	if err != nil {
+		t.Fatalf("DrawRows4bpp failed: %v", err)
+	}
+	for y := 0; y < 5; y++ {
+		if calls[y] != Frames4bpp {
+			t.Errorf("Row %d: expected %d reads, got %d", y, Frames4bpp, calls[y])
+		}
+	}
+}
+
+func TestDrawRowsStopsOnError(t *testing.T) {
+	d := newTestDevice(100, 100)
+	boom := errors.New("boom")
+	n := 0
+	src := RowFunc(func(y int, dst []byte) error {
+		n++
+		if y == 3 {
+			return boom
+		}
+		return nil
+	})
+	if err := d.DrawRows4bpp(0, 0, 20, 10, src, BlackOnWhite); err != boom {
+		t.Errorf("Expected boom, got %v", err)
+	}
+	if n != 4 {
+		t.Errorf("Expected the draw to stop at the failing row, got %d reads", n)
+	}
+
+	n = 0
+	if err := d.DrawRows1bpp(0, 0, 20, 10, src, 10); err != boom {
+		t.Errorf("Expected boom, got %v", err)
+	}
+	if n != 4 {
+		t.Errorf("Expected the 1bpp draw to stop at the failing row, got %d reads", n)
+	}
+
+	// Out-of-range draws are ignored without reading.
+	n = 0
+	if err := d.DrawRows1bpp(90, 0, 20, 10, src, 10); err != nil || n != 0 {
+		t.Errorf("Expected a clipped draw to be skipped, got %v after %d reads", err, n)
+	}
+}
+
+func TestBitmapRowSource(t *testing.T) {
+	b := NewBitmap4bpp(5, 2)
+	b.SetLevel(4, 1, 15)
+	row := make([]byte, b.Stride)
+	if err := b.ReadRow(1, row); err != nil || row[2] != 0xF0 {
+		t.Errorf("Unexpected row % x (%v)", row, err)
+	}
+	if err := b.ReadRow(2, row); err != ErrShortData {
+		t.Errorf("Expected ErrShortData past the end, got %v", err)
+	}
+
+	m := NewBitmap1bpp(9, 1)
+	m.Set(8, 0, true)
+	row = make([]byte, m.Stride)
+	if err := m.ReadRow(0, row); err != nil || row[1] != 0x80 {
+		t.Errorf("Unexpected 1bpp row % x (%v)", row, err)
+	}
+
+	// Bitmaps draw directly.
+	d := newTestDevice(100, 100)
+	if err := d.DrawRows4bpp(0, 0, b.Width, b.Height, b, BlackOnWhite); err != nil {
+		t.Errorf("Drawing a bitmap failed: %v", err)
+	}
+}
+
+func TestDrawAsset(t *testing.T) {
+	b := NewBitmap4bpp(30, 4)
+	b.Fill(9)
+	enc, err := asset.Encode(asset.FromPacked(4, b.Width, b.Height, b.Pix), asset.PackBits)
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	a, err := asset.Open(string(enc))
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	var _ RowSource = a.Rows4bpp()
+
+	d := newTestDevice(100, 100)
+	if err := d.DrawAsset(10, 10, a, BlackOnWhite); err != nil {
+		t.Errorf("DrawAsset failed: %v", err)
+	}
+	if err := d.DrawRows1bpp(10, 10, a.Width(), a.Height(), a.Rows1bpp(), 10); err != nil {
+		t.Errorf("DrawRows1bpp from an asset failed: %v", err)
+	}
+}
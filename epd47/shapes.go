@@ -0,0 +1,68 @@
+package epd47
+
+import "image"
+
+// FillRect sets every pixel of r to level, clipped to the bitmap.
+func (b *Bitmap4bpp) FillRect(r image.Rectangle, level uint8) {
+	r = r.Intersect(b.Bounds())
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			b.SetLevel(x, y, level)
+		}
+	}
+}
+
+// StrokeRect draws the outline of r, width pixels thick, inside r.
+func (b *Bitmap4bpp) StrokeRect(r image.Rectangle, width int, level uint8) {
+	if width <= 0 || r.Empty() {
+		return
+	}
+	if 2*width >= r.Dx() || 2*width >= r.Dy() {
+		b.FillRect(r, level)
+		return
+	}
+	b.FillRect(image.Rect(r.Min.X, r.Min.Y, r.Max.X, r.Min.Y+width), level)
+	b.FillRect(image.Rect(r.Min.X, r.Max.Y-width, r.Max.X, r.Max.Y), level)
+	b.FillRect(image.Rect(r.Min.X, r.Min.Y+width, r.Min.X+width, r.Max.Y-width), level)
+	b.FillRect(image.Rect(r.Max.X-width, r.Min.Y+width, r.Max.X, r.Max.Y-width), level)
+}
+
+// HLine draws a horizontal line from x0 to x1 inclusive.
+func (b *Bitmap4bpp) HLine(x0, x1, y int, level uint8) {
+	b.FillRect(image.Rect(min(x0, x1), y, max(x0, x1)+1, y+1), level)
+}
+
+// VLine draws a vertical line from y0 to y1 inclusive.
+func (b *Bitmap4bpp) VLine(x, y0, y1 int, level uint8) {
+	b.FillRect(image.Rect(x, min(y0, y1), x+1, max(y0, y1)+1), level)
+}
+
+// Line draws a 1px line from x0,y0 to x1,y1 inclusive with Bresenham's
+// algorithm. Lines are not anti-aliased, which keeps them crisp on the
+// panel.
+func (b *Bitmap4bpp) Line(x0, y0, x1, y1 int, level uint8) {
+	dx, sx := x1-x0, 1
+	if dx < 0 {
+		dx, sx = -dx, -1
+	}
+	dy, sy := y1-y0, 1
+	if dy < 0 {
+		dy, sy = -dy, -1
+	}
+	e := dx - dy
+	for {
+		b.SetLevel(x0, y0, level)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * e
+		if e2 > -dy {
+			e -= dy
+			x0 += sx
+		}
+		if e2 < dx {
+			e += dx
+			y0 += sy
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package epd47
+
+import (
+	"image"
+	"testing"
+
+	"github.com/abaschen/tinygo-epd47-s3/asset"
+)
+
+var _ Sprite = (*asset.Sprite)(nil)
+
+// arrow returns a 2x3 sprite sheet sprite with transparent right column
+// below the top row:
+//
+//	9 5
+//	9 .
+//	9 .
+func arrow(t *testing.T) *asset.Sprite {
+	t.Helper()
+	enc, err := asset.EncodeSheet([]asset.SpriteImage{{
+		Name: "arrow", Width: 2, Height: 3,
+		Pix:  []byte{0x95, 0x90, 0x90},
+		Mask: []byte{0xC0, 0x80, 0x80},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := asset.OpenSheet(string(enc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s.Lookup("arrow")
+}
+
+func TestBlit(t *testing.T) {
+	sp := arrow(t)
+	cases := []struct {
+		r     Rotation
+		scale int
+		want  []string // '.' keeps the background level 2
+	}{
+		{Rotate0, 1, []string{"95", "9.", "9."}},
+		{Rotate90, 1, []string{"999", "..5"}},
+		{Rotate180, 1, []string{".9", ".9", "59"}},
+		{Rotate270, 1, []string{"5..", "999"}},
+		{Rotate0, 2, []string{"9955", "9955", "99..", "99..", "99..", "99.."}},
+	}
+	for _, c := range cases {
+		b := NewBitmap4bpp(8, 8)
+		b.Fill(2)
+		b.Blit(1, 1, sp, c.scale, c.r)
+		for y, row := range c.want {
+			for x := 0; x < len(row); x++ {
+				want := uint8(2)
+				if row[x] != '.' {
+					want = row[x] - '0'
+				}
+				if got := b.Level(1+x, 1+y); got != want {
+					t.Errorf("Rotation %d scale %d: pixel %d,%d expected %d, got %d", c.r, c.scale, x, y, want, got)
+				}
+			}
+		}
+		if b.Level(0, 0) != 2 || b.Level(7, 7) != 2 {
+			t.Errorf("Rotation %d: expected nothing outside the sprite", c.r)
+		}
+	}
+
+	// Clipped at the edges without panicking.
+	b := NewBitmap4bpp(2, 2)
+	b.Blit(-1, 1, sp, 3, Rotate90)
+	if b.Level(0, 1) != 9 {
+		t.Errorf("Expected the clipped sprite, got %d", b.Level(0, 1))
+	}
+}
+
+func TestSpriteLayer(t *testing.T) {
+	c := NewCanvas(6, 6)
+	c.Background = 2
+	l := c.Push(SpriteLayer(arrow(t), image.Pt(1, 2), 1, Rotate90))
+	if l.Bounds() != image.Rect(1, 2, 4, 4) {
+		t.Errorf("Unexpected layer bounds %v", l.Bounds())
+	}
+	out := NewBitmap4bpp(6, 6)
+	c.Composite(out)
+	if out.Level(1, 2) != 9 || out.Level(3, 3) != 5 || out.Level(1, 3) != 2 {
+		t.Errorf("Expected the rotated sprite over the background, got %d %d %d", out.Level(1, 2), out.Level(3, 3), out.Level(1, 3))
+	}
+}
@@ -0,0 +1,123 @@
+package epd47
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"strings"
+
+	"github.com/abaschen/tinygo-epd47-s3/asset"
+)
+
+// Ghosting counts the updates since the last full Clear. Each partial
+// update leaves a little residue of the previous image, so applications
+// schedule a full Clear once the counts grow.
+type Ghosting struct {
+	Draws      uint32 // 1bpp and 4bpp draw passes
+	AreaClears uint32 // ClearArea calls
+}
+
+// Ghosting returns the updates since the last full Clear.
+func (d *Device) Ghosting() Ghosting { return d.ghost }
+
+// ErrState is returned by RestoreState for data that is not a saved state
+// of a panel this size, or that is corrupt.
+var ErrState = errors.New("epd47: invalid saved display state")
+
+// A saved state is a small header, the readback model as a PackBits
+// compressed asset and a CRC:
+//
+//	0  "EPDT"
+//	4  version (1)
+//	5  reserved (0, 0, 0)
+//	8  Ghosting.Draws, uint32 little-endian
+//	12 Ghosting.AreaClears, uint32 little-endian
+//	16 asset length, uint32 little-endian
+//	20 asset
+//	   CRC-32 (IEEE) of everything before, uint32 little-endian
+const (
+	stateMagic     = "EPDT"
+	stateVersion   = 1
+	stateHeaderLen = 20
+)
+
+// SaveState writes what the panel shows and the ghosting counters to w, so
+// that after a deep sleep RestoreState can pick up where the device left
+// off instead of clearing the screen. The image is taken from the readback
+// model and compressed row by row as it is written, so only one row is
+// held beyond the model; SaveState returns ErrNoReadback when readback is
+// off. Mostly white screens compress to a few kilobytes.
+func (d *Device) SaveState(w io.Writer) error {
+	if d.shown == nil {
+		return ErrNoReadback
+	}
+	img := &asset.Image{Width: d.w, Height: d.h, BPP: 4, Pix: d.shown.Pix}
+	n, err := asset.EncodedSize(img, asset.PackBits)
+	if err != nil {
+		return err
+	}
+	crc := crc32.NewIEEE()
+	mw := io.MultiWriter(w, crc)
+
+	hdr := make([]byte, 0, stateHeaderLen)
+	hdr = append(hdr, stateMagic...)
+	hdr = append(hdr, stateVersion, 0, 0, 0)
+	hdr = binary.LittleEndian.AppendUint32(hdr, d.ghost.Draws)
+	hdr = binary.LittleEndian.AppendUint32(hdr, d.ghost.AreaClears)
+	hdr = binary.LittleEndian.AppendUint32(hdr, uint32(n))
+	if _, err := mw.Write(hdr); err != nil {
+		return err
+	}
+	if err := asset.EncodeTo(mw, img, asset.PackBits); err != nil {
+		return err
+	}
+	_, err = w.Write(binary.LittleEndian.AppendUint32(nil, crc.Sum32()))
+	return err
+}
+
+// RestoreState reads a state written by SaveState, turning readback on
+// and restoring the model and ghosting counters. Nothing is drawn: the
+// panel still shows the image, so partial updates can continue. The CRC
+// is checked as the data streams in, before anything is decoded, and the
+// stored length is bounded by the largest possible frame. On error the
+// device is left unchanged and the caller should Clear.
+func (d *Device) RestoreState(r io.Reader) error {
+	crc := crc32.NewIEEE()
+	tr := io.TeeReader(r, crc)
+	var hdr [stateHeaderLen]byte
+	if _, err := io.ReadFull(tr, hdr[:]); err != nil {
+		return ErrState
+	}
+	n := binary.LittleEndian.Uint32(hdr[16:])
+	if string(hdr[:4]) != stateMagic || hdr[4] != stateVersion || n > uint32(asset.MaxEncodedSize(d.w, d.h, 4)) {
+		return ErrState
+	}
+	var data strings.Builder
+	data.Grow(int(n))
+	if m, err := io.CopyN(&data, tr, int64(n)); err != nil || m != int64(n) {
+		return ErrState
+	}
+	var sum [4]byte
+	if _, err := io.ReadFull(r, sum[:]); err != nil || binary.LittleEndian.Uint32(sum[:]) != crc.Sum32() {
+		return ErrState
+	}
+
+	a, err := asset.Open(data.String())
+	if err != nil || a.Width() != d.w || a.Height() != d.h {
+		return ErrState
+	}
+	shown := NewBitmap4bpp(d.w, d.h)
+	rows := a.Rows4bpp()
+	for y := 0; y < d.h; y++ {
+		if err := rows.ReadRow(y, shown.Pix[y*shown.Stride:(y+1)*shown.Stride]); err != nil {
+			return ErrState
+		}
+	}
+	d.shown = shown
+	d.ghost = Ghosting{
+		Draws:      binary.LittleEndian.Uint32(hdr[8:]),
+		AreaClears: binary.LittleEndian.Uint32(hdr[12:]),
+	}
+	return nil
+}
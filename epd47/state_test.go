@@ -0,0 +1,83 @@
+package epd47
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"testing"
+)
+
+func TestGhostingCounters(t *testing.T) {
+	d := newTestDevice(16, 8)
+	bm := NewBitmap4bpp(4, 2)
+	d.DrawImage4bpp(0, 0, 4, 2, bm.Pix, BlackOnWhite)
+	d.Draw1bpp(0, 0, 8, 1, []byte{0xFF}, 10)
+	d.ClearArea(image.Rect(0, 0, 4, 4), 1)
+	if g := d.Ghosting(); g.Draws != 2 || g.AreaClears != 1 {
+		t.Errorf("Expected 2 draws and 1 area clear, got %+v", g)
+	}
+	d.Clear(1)
+	if d.Ghosting() != (Ghosting{}) {
+		t.Error("Expected a full Clear to reset the counters")
+	}
+}
+
+func TestSaveRestoreState(t *testing.T) {
+	d := newTestDevice(64, 32)
+	if err := d.SaveState(&bytes.Buffer{}); err != ErrNoReadback {
+		t.Errorf("Expected ErrNoReadback, got %v", err)
+	}
+	d.SetReadback(true)
+	bm := NewBitmap4bpp(16, 8)
+	for x := 0; x < 16; x++ {
+		bm.SetLevel(x, x%8, uint8(x))
+	}
+	d.DrawImage4bpp(10, 4, 16, 8, bm.Pix, BlackOnWhite)
+	d.ClearArea(image.Rect(40, 0, 50, 10), 1)
+
+	var buf bytes.Buffer
+	if err := d.SaveState(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() > 64*32/2 {
+		t.Errorf("Expected the state to be compressed, got %d bytes", buf.Len())
+	}
+	saved := append([]byte(nil), buf.Bytes()...)
+
+	// A freshly booted device knows nothing until it restores.
+	w := newTestDevice(64, 32)
+	if err := w.RestoreState(bytes.NewReader(saved)); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(w.Displayed().Pix, d.Displayed().Pix) || w.Ghosting() != d.Ghosting() {
+		t.Error("Expected the restored image and counters to match")
+	}
+	if w.Displayed().Level(25, 11) != 15 {
+		t.Errorf("Expected level 15 at 25,11, got %d", w.Displayed().Level(25, 11))
+	}
+
+	// Corrupt, truncated or foreign states are rejected without changes.
+	bad := append([]byte(nil), saved...)
+	bad[len(bad)/2] ^= 1
+	other := newTestDevice(32, 32)
+	huge := append([]byte(nil), saved...)
+	binary.LittleEndian.PutUint32(huge[16:], 0xFFFFFFF0) // must not be allocated
+	for name, c := range map[string]struct {
+		dev  *Device
+		data []byte
+	}{
+		"corrupt":   {w, bad},
+		"truncated": {w, saved[:len(saved)-3]},
+		"size":      {other, saved},
+		"magic":     {w, append([]byte("XXXX"), saved[4:]...)},
+		"length":    {w, huge},
+	} {
+		before := c.dev.Displayed()
+		if err := c.dev.RestoreState(bytes.NewReader(c.data)); err != ErrState {
+			t.Errorf("%s: expected ErrState, got %v", name, err)
+		}
+		if c.dev.Displayed() != before {
+			t.Errorf("%s: expected the device unchanged", name)
+		}
+	}
+}
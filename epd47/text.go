@@ -0,0 +1,64 @@
+package epd47
+
+import (
+	"image"
+
+	"github.com/abaschen/tinygo-epd47-s3/font"
+)
+
+// DefaultFont is used by the text helpers when no font is given.
+var DefaultFont = font.DejaVuSans16
+
+// DrawText renders anti-aliased text with its top-left corner at x,y using
+// the 4bpp pipeline. Newlines start a new line; nil selects DefaultFont.
+func (d *Device) DrawText(x, y int, text string, f *font.Font) {
+	if f == nil {
+		f = DefaultFont
+	}
+	w, h := f.Measure(text, 0)
+	d.DrawTextBox(image.Rect(x, y, x+w, y+h), text, f, font.AlignLeft)
+}
+
+// DrawTextBox renders text wrapped to the width of r and aligned inside it.
+// Lines that do not fit vertically are clipped; nil selects DefaultFont.
+// The text is rendered a band of about one line at a time while it is
+// drawn, so only that band is buffered.
+func (d *Device) DrawTextBox(r image.Rectangle, text string, f *font.Font, align font.Align) {
+	if f == nil {
+		f = DefaultFont
+	}
+	vis := r.Intersect(image.Rect(0, 0, d.w, d.h))
+	if vis.Empty() || len(text) == 0 {
+		return
+	}
+
+	src := &textRows{
+		f:     f,
+		text:  text,
+		align: align,
+		box:   r.Sub(vis.Min),
+		band:  NewBitmap4bpp(vis.Dx(), min(max(f.LineHeight, 1), vis.Dy())),
+		y0:    -1,
+	}
+	d.DrawRows4bpp(vis.Min.X, vis.Min.Y, vis.Dx(), vis.Dy(), src, BlackOnWhite)
+}
+
+// textRows renders a text box in bands of rows as they are read.
+type textRows struct {
+	f     *font.Font
+	text  string
+	align font.Align
+	box   image.Rectangle // relative to row 0
+	band  *Bitmap4bpp     // rows y0 to y0+band.Height
+	y0    int
+}
+
+// ReadRow implements RowSource.
+func (t *textRows) ReadRow(y int, dst []byte) error {
+	if t.y0 < 0 || y < t.y0 || y >= t.y0+t.band.Height {
+		t.y0 = y
+		t.band.Fill(0)
+		font.DrawBoxClip(t.band, t.f, t.box.Sub(image.Pt(0, y)), t.band.Bounds(), t.text, t.align, 15)
+	}
+	return t.band.ReadRow(y-t.y0, dst)
+}
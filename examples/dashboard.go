@@ -0,0 +1,72 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+	"github.com/abaschen/tinygo-epd47-s3/font"
+	"github.com/abaschen/tinygo-epd47-s3/layout"
+	"github.com/abaschen/tinygo-epd47-s3/widget"
+)
+
+// A sensor dashboard laid out without pixel offsets; it switches between
+// landscape and portrait every few updates to show the automatic re-layout.
+func main() {
+	display := epd47.NewLilyGoT547()
+	display.Initialize()
+
+	title := widget.NewLabel("Greenhouse", font.DejaVuSans24)
+	title.Align = font.AlignCenter
+	temp := widget.NewValueBox("Temperature", "°C")
+	humidity := widget.NewValueBox("Humidity", "%")
+	gauge := widget.NewGauge(0, 100)
+	gauge.Unit = "%"
+	history := widget.NewSparkline(96)
+	history.Fill = 3
+	battery := widget.NewBattery()
+	battery.Low = 15
+
+	header := layout.Row(
+		layout.Flex(1, title),
+		layout.Child{Node: battery, Size: layout.Px(60), Cross: 28, Align: layout.Center},
+	)
+	values := layout.Column(layout.Flex(1, temp), layout.Flex(1, humidity))
+	values.Gap = 10
+	body := layout.Row(layout.Flex(1, values), layout.Flex(1, gauge))
+	body.Gap = 10
+	root := layout.Column(
+		layout.Fixed(40, header),
+		layout.Flex(3, body),
+		layout.Flex(1, history),
+	)
+	root.Padding = layout.Pad(20)
+	root.Gap = 16
+
+	screen := widget.NewScreen(display)
+	screen.Add(title, battery, temp, humidity, gauge, history)
+	screen.SetLayout(root)
+
+	t, h := float32(21.5), float32(48)
+	for i := 0; ; i++ {
+		if i%10 == 9 {
+			screen.SetRotation(screen.Rotation() ^ epd47.Rotate90)
+		}
+		t += 0.3 * float32(i%5-2)
+		h += float32(i%3 - 1)
+		temp.SetValue(strconv.FormatFloat(float64(t), 'f', 1, 32))
+		humidity.SetValue(strconv.Itoa(int(h)))
+		gauge.SetValue(h)
+		history.Push(t)
+		battery.SetPercent(100 - i%100)
+
+		display.PowerOn()
+		n, err := screen.Update()
+		if err != nil {
+			println("update:", err.Error())
+		}
+		println("partial updates:", n)
+		display.PowerOff()
+		time.Sleep(30 * time.Second)
+	}
+}
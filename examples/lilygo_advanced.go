@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/abaschen/tinygo-epd47-s3/epd47"
+	"github.com/abaschen/tinygo-epd47-s3/font"
 )
 
 // Create a more complex pattern
@@ -116,7 +117,7 @@ func main() {
 	display.Draw1bpp(patternX, patternY, patternW, patternH, complexData, 10)
 	
 	// Add some text labels
-	display.DrawText(patternX, patternY-30, "Complex Pattern", 8, 16)
+	display.DrawText(patternX, patternY-30, "Complex Pattern", font.DejaVuSans16)
 	
 	time.Sleep(3 * time.Second)
 
@@ -134,7 +135,7 @@ func main() {
 	display.DrawImage4bpp(mandalaX, mandalaY, mandalaW, mandalaH, mandalaData, epd47.BlackOnWhite)
 	
 	// Add title
-	display.DrawText(mandalaX, mandalaY-40, "Grayscale Mandala", 10, 20)
+	display.DrawText(mandalaX, mandalaY-40, "Grayscale Mandala", font.DejaVuSans24)
 	
 	time.Sleep(4 * time.Second)
 
@@ -144,33 +145,33 @@ func main() {
 	display.Clear(1)
 	
 	// Title
-	display.DrawText(50, 30, "EPD47 Driver Demo", 16, 24)
+	display.DrawText(50, 30, "EPD47 Driver Demo", font.DejaVuSans24)
 	
 	// Left column - 1bpp content
-	display.DrawText(50, 80, "1bpp Graphics:", 8, 16)
+	display.DrawText(50, 80, "1bpp Graphics:", font.DejaVuSans16)
 	display.DrawCheckerboard(50, 110, 100, 80, 12)
 	display.DrawRectangle(50, 210, 100, 60, false)
 	display.DrawRectangle(60, 220, 80, 40, true)
 	
 	// Center column - gradients
-	display.DrawText(200, 80, "4bpp Gradients:", 8, 16)
+	display.DrawText(200, 80, "4bpp Gradients:", font.DejaVuSans16)
 	display.DrawGradient(200, 110, 150, 40, true, epd47.BlackOnWhite)
 	display.DrawGradient(200, 160, 40, 100, false, epd47.BlackOnWhite)
 	display.DrawGradient(250, 160, 100, 100, true, epd47.WhiteOnBlack)
 	
 	// Right column - patterns
-	display.DrawText(400, 80, "Patterns:", 8, 16)
+	display.DrawText(400, 80, "Patterns:", font.DejaVuSans16)
 	for i := 0; i < 6; i++ {
 		y := 110 + i*25
 		display.DrawRectangle(400, y, 150, 20, i%2 == 0)
 	}
 	
 	// Bottom section - large gradient
-	display.DrawText(50, 300, "Full Width Gradient:", 8, 16)
+	display.DrawText(50, 300, "Full Width Gradient:", font.DejaVuSans16)
 	display.DrawGradient(50, 330, width-100, 60, true, epd47.BlackOnWhite)
 	
 	// Footer
-	display.DrawText(50, 450, "LilyGo T5 4.7\" ESP32-S3 - TinyGo Driver", 6, 12)
+	display.DrawText(50, 450, "LilyGo T5 4.7\" ESP32-S3 - TinyGo Driver", font.DejaVuSans16)
 	
 	time.Sleep(5 * time.Second)
 
@@ -200,7 +201,7 @@ func main() {
 		}
 		
 		// Add frame counter
-		display.DrawText(20, 20, "Frame:", 8, 16)
+		display.DrawText(20, 20, "Frame:", font.DejaVuSans16)
 		// Simple number display (just show pattern based on frame)
 		display.DrawRectangle(100, 20, frame*10, 16, true)
 		
@@ -211,7 +212,7 @@ func main() {
 
 	// Final scene
 	println("Demo complete!")
-	display.DrawText(centerX-100, centerY-10, "Demo Complete!", 12, 20)
+	display.DrawText(centerX-100, centerY-10, "Demo Complete!", font.DejaVuSans24)
 	
 	time.Sleep(3 * time.Second)
 	
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/abaschen/tinygo-epd47-s3/epd47"
+	"github.com/abaschen/tinygo-epd47-s3/font"
 )
 
 func main() {
@@ -33,8 +34,8 @@ func main() {
 	display.DrawGradient(300, 200, 80, 120, false, epd47.BlackOnWhite) // Vertical
 	time.Sleep(1 * time.Second)
 
-	// Demo 4: Draw some "text" (simple pattern)
-	display.DrawText(50, 350, "Hello EPD47!", 12, 20)
+	// Demo 4: Draw text in DejaVu Sans 24px
+	display.DrawText(50, 350, "Hello EPD47!", font.DejaVuSans24)
 	time.Sleep(2 * time.Second)
 
 	// Demo 5: Full screen effects
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/abaschen/tinygo-epd47-s3/epd47"
+	"github.com/abaschen/tinygo-epd47-s3/font"
 )
 
 // Benchmark function to demonstrate clear() performance
@@ -67,7 +68,7 @@ func main() {
 	
 	// 4. Text drawing (buffer initialization optimized)
 	println("4. Drawing text (buffer management optimized)")
-	display.DrawText(100, 300, "Optimized with clear()!", 8, 16)
+	display.DrawText(100, 300, "Optimized with clear()!", font.DejaVuSans16)
 	
 	time.Sleep(3 * time.Second)
 	
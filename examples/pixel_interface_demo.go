@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/abaschen/tinygo-epd47-s3/epd47"
+	"github.com/abaschen/tinygo-epd47-s3/font"
 )
 
 func main() {
@@ -161,7 +162,7 @@ func main() {
 	time.Sleep(2 * time.Second)
 	
 	// Final message
-	display.DrawText(50, 450, "Pixel Interface Demo Complete!", 8, 16)
+	display.DrawText(50, 450, "Pixel Interface Demo Complete!", font.DejaVuSans16)
 	display.Display()
 	
 	println("\nDemo Summary:")
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/abaschen/tinygo-epd47-s3/epd47"
+	"github.com/abaschen/tinygo-epd47-s3/font"
 )
 
 // Example using the Displayer interface
@@ -48,7 +49,7 @@ func main() {
 	lilygo.DrawCheckerboard(50, 50, 200, 100, 16)
 	lilygo.DrawGradient(300, 50, 200, 100, true, epd47.BlackOnWhite)
 	lilygo.DrawRectangle(50, 200, 150, 80, false)
-	lilygo.DrawText(250, 220, "TinyGo EPD47", 8, 16)
+	lilygo.DrawText(250, 220, "TinyGo EPD47", font.DejaVuSans16)
 	
 	time.Sleep(3 * time.Second)
 	
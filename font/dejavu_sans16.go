@@ -0,0 +1,280 @@
+// Code generated by fontconv -in DejaVuSans.ttf -size 16 -ranges 32-126,0xB0 -name DejaVuSans16 -out dejavu_sans16.go; DO NOT EDIT.
+
+package font
+
+// DejaVuSans16 is DejaVu Sans 16px converted from DejaVuSans.ttf.
+var DejaVuSans16 = &Font{
+	Name:       "DejaVu Sans 16px",
+	Ascent:     15,
+	Descent:    4,
+	LineHeight: 19,
+	Glyphs:     dejaVuSans16Glyphs[:],
+	Kerning:    dejaVuSans16Kerning[:],
+	Bitmap:     dejaVuSans16Bitmap,
+	Compressed: true,
+	Fallback:   '?',
+}
+
+var dejaVuSans16Glyphs = [...]Glyph{
+	{' ', 0, 0, 0, 5, 0, 0},
+	{'!', 0, 2, 12, 6, 2, 12},
+	{'"', 16, 5, 5, 7, 1, 12},
+	{'#', 31, 12, 12, 13, 1, 12},
+	{'$', 94, 8, 16, 10, 1, 13},
+	{'%', 151, 15, 13, 15, 0, 12},
+	{'&', 238, 11, 13, 12, 1, 12},
+	{'\'', 301, 2, 5, 4, 1, 12},
+	{'(', 306, 4, 16, 6, 1, 13},
+	{')', 347, 4, 16, 6, 1, 13},
+	{'*', 383, 8, 8, 8, 0, 12},
+	{'+', 412, 11, 10, 13, 1, 10},
+	{',', 442, 3, 4, 5, 1, 2},
+	{'-', 450, 5, 2, 6, 0, 5},
+	{'.', 454, 3, 2, 5, 1, 2},
+	{'/', 458, 6, 14, 5, 0, 12},
+	{'0', 491, 9, 13, 10, 1, 12},
+	{'1', 548, 8, 12, 10, 1, 12},
+	{'2', 590, 8, 12, 10, 1, 12},
+	{'3', 629, 8, 13, 10, 1, 12},
+	{'4', 672, 10, 12, 10, 0, 12},
+	{'5', 717, 8, 13, 10, 1, 12},
+	{'6', 763, 9, 13, 10, 1, 12},
+	{'7', 819, 8, 12, 10, 1, 12},
+	{'8', 855, 9, 13, 10, 1, 12},
+	{'9', 916, 8, 13, 10, 1, 12},
+	{':', 965, 3, 9, 5, 1, 9},
+	{';', 975, 3, 11, 5, 1, 9},
+	{'<', 989, 11, 10, 13, 1, 10},
+	{'=', 1025, 11, 6, 13, 1, 8},
+	{'>', 1052, 11, 10, 13, 1, 10},
+	{'?', 1090, 7, 12, 9, 1, 12},
+	{'@', 1121, 14, 15, 16, 1, 12},
+	{'A', 1217, 11, 12, 11, 0, 12},
+	{'B', 1269, 9, 12, 11, 1, 12},
+	{'C', 1329, 11, 13, 11, 0, 12},
+	{'D', 1379, 11, 12, 12, 1, 12},
+	{'E', 1445, 9, 12, 10, 1, 12},
+	{'F', 1492, 8, 12, 9, 1, 12},
+	{'G', 1535, 12, 13, 12, 0, 12},
+	{'H', 1599, 10, 12, 12, 1, 12},
+	{'I', 1660, 3, 12, 5, 1, 12},
+	{'J', 1684, 5, 16, 5, -1, 12},
+	{'K', 1732, 10, 12, 11, 1, 12},
+	{'L', 1795, 8, 12, 9, 1, 12},
+	{'M', 1831, 12, 12, 14, 1, 12},
+	{'N', 1912, 10, 12, 12, 1, 12},
+	{'O', 1982, 12, 13, 13, 0, 12},
+	{'P', 2048, 9, 12, 10, 1, 12},
+	{'Q', 2101, 12, 15, 13, 0, 12},
+	{'R', 2174, 10, 12, 11, 1, 12},
+	{'S', 2238, 9, 13, 10, 1, 12},
+	{'T', 2288, 10, 12, 10, 0, 12},
+	{'U', 2320, 10, 13, 12, 1, 12},
+	{'V', 2378, 11, 12, 11, 0, 12},
+	{'W', 2433, 16, 12, 16, 0, 12},
+	{'X', 2528, 11, 12, 11, 0, 12},
+	{'Y', 2586, 10, 12, 10, 0, 12},
+	{'Z', 2626, 11, 12, 11, 0, 12},
+	{'[', 2670, 4, 16, 6, 1, 13},
+	{'\\', 2703, 6, 14, 5, 0, 12},
+	{']', 2735, 4, 16, 6, 1, 13},
+	{'^', 2768, 11, 5, 13, 1, 12},
+	{'_', 2790, 10, 2, 8, -1, -2},
+	{'`', 2800, 4, 4, 8, 1, 13},
+	{'a', 2807, 8, 10, 10, 1, 9},
+	{'b', 2848, 9, 14, 10, 1, 13},
+	{'c', 2902, 8, 10, 9, 0, 9},
+	{'d', 2935, 9, 14, 10, 0, 13},
+	{'e', 2988, 9, 10, 10, 0, 9},
+	{'f', 3027, 6, 13, 6, 0, 13},
+	{'g', 3069, 9, 13, 10, 0, 9},
+	{'h', 3129, 8, 13, 10, 1, 13},
+	{'i', 3169, 2, 13, 4, 1, 13},
+	{'j', 3182, 4, 17, 4, -1, 13},
+	{'k', 3215, 8, 13, 9, 1, 13},
+	{'l', 3260, 2, 13, 4, 1, 13},
+	{'m', 3273, 14, 9, 16, 1, 9},
+	{'n', 3334, 8, 9, 10, 1, 9},
+	{'o', 3366, 9, 10, 10, 0, 9},
+	{'p', 3411, 9, 13, 10, 1, 9},
+	{'q', 3465, 9, 13, 10, 0, 9},
+	{'r', 3517, 6, 9, 7, 1, 9},
+	{'s', 3539, 8, 10, 8, 0, 9},
+	{'t', 3576, 6, 12, 6, 0, 12},
+	{'u', 3608, 8, 10, 10, 1, 9},
+	{'v', 3642, 9, 9, 9, 0, 9},
+	{'w', 3682, 13, 9, 13, 0, 9},
+	{'x', 3747, 9, 9, 9, 0, 9},
+	{'y', 3786, 9, 13, 9, 0, 9},
+	{'z', 3834, 8, 9, 8, 0, 9},
+	{'{', 3864, 7, 16, 10, 2, 13},
+	{'|', 3905, 2, 17, 5, 2, 13},
+	{'}', 3930, 7, 16, 10, 2, 13},
+	{'~', 3970, 11, 4, 13, 1, 7},
+	{'\u00b0', 3987, 6, 5, 8, 1, 12},
+}
+
+var dejaVuSans16Kerning = [...]Kern{
+	{'-', 'B', -1}, {'-', 'G', 1}, {'-', 'J', 1}, {'-', 'Q', 1},
+	{'-', 'T', -1}, {'-', 'V', -1}, {'-', 'W', -1}, {'-', 'X', -1},
+	{'-', 'Y', -2}, {'A', 'T', -1}, {'A', 'V', -1}, {'A', 'W', -1},
+	{'A', 'Y', -1}, {'A', 'f', -1}, {'A', 'v', -1}, {'A', 'w', -1},
+	{'A', 'y', -1}, {'B', 'W', -1}, {'B', 'Y', -1}, {'D', 'Y', -1},
+	{'F', '.', -3}, {'F', ':', -1}, {'F', 'A', -1}, {'F', 'a', -1},
+	{'F', 'e', -1}, {'F', 'i', -1}, {'F', 'o', -1}, {'F', 'r', -1},
+	{'F', 'u', -1}, {'F', 'y', -1}, {'G', 'T', -1}, {'G', 'Y', -1},
+	{'J', '-', -1}, {'K', '-', -2}, {'K', 'C', -1}, {'K', 'O', -1},
+	{'K', 'T', -1}, {'K', 'W', -1}, {'K', 'Y', -1}, {'K', 'e', -1},
+	{'K', 'o', -1}, {'K', 'u', -1}, {'K', 'y', -1}, {'L', 'O', -1},
+	{'L', 'T', -2}, {'L', 'U', -1}, {'L', 'V', -2}, {'L', 'W', -1},
+	{'L', 'Y', -2}, {'L', 'y', -1}, {'O', '.', -1}, {'O', 'X', -1},
+	{'O', 'Y', -1}, {'P', '.', -2}, {'P', 'A', -1}, {'P', 'a', -1},
+	{'P', 'e', -1}, {'P', 'o', -1}, {'R', '-', -1}, {'R', '.', -1},
+	{'R', 'A', -1}, {'R', 'C', -1}, {'R', 'T', -1}, {'R', 'V', -1},
+	{'R', 'W', -1}, {'R', 'Y', -1}, {'R', 'e', -1}, {'R', 'o', -1},
+	{'R', 'u', -1}, {'R', 'y', -1}, {'T', '-', -1}, {'T', '.', -2},
+	{'T', ':', -2}, {'T', 'A', -1}, {'T', 'C', -1}, {'T', 'a', -3},
+	{'T', 'c', -3}, {'T', 'e', -3}, {'T', 'o', -3}, {'T', 'r', -2},
+	{'T', 's', -3}, {'T', 'u', -2}, {'T', 'w', -3}, {'T', 'y', -2},
+	{'V', '-', -1}, {'V', '.', -2}, {'V', ':', -1}, {'V', 'A', -1},
+	{'V', 'a', -1}, {'V', 'e', -1}, {'V', 'o', -1}, {'V', 'u', -1},
+	{'W', '-', -1}, {'W', '.', -2}, {'W', ':', -1}, {'W', 'A', -1},
+	{'W', 'a', -1}, {'W', 'e', -1}, {'W', 'o', -1}, {'W', 'r', -1},
+	{'W', 'u', -1}, {'X', '-', -1}, {'X', 'C', -1}, {'X', 'O', -1},
+	{'X', 'e', -1}, {'Y', '-', -2}, {'Y', '.', -3}, {'Y', ':', -2},
+	{'Y', 'A', -1}, {'Y', 'C', -1}, {'Y', 'O', -1}, {'Y', 'a', -2},
+	{'Y', 'e', -2}, {'Y', 'i', -1}, {'Y', 'o', -2}, {'Y', 'u', -2},
+	{'f', '-', -1}, {'f', '.', -1}, {'f', ':', -1}, {'k', 'e', -1},
+	{'k', 'o', -1}, {'k', 'y', -1}, {'r', '-', -1}, {'r', '.', -1},
+	{'v', '.', -1}, {'v', ':', -1}, {'w', '.', -1}, {'w', ':', -1},
+	{'y', '.', -2}, {'y', ':', -1},
+}
+
+// 4004 bytes
+const dejaVuSans16Bitmap = "" +
+	"\x6a\x9f\x09\xf0\x9f\x09\xf0\x9f\x08\xe7\xd2\x30\x19\xf0\x9f\x00\x59\x00\x58\x7d\x00\x8c\x7d\x00\x8c\x7d\x00\x8c\x59\x00\x58\x03" +
+	"\x27\x01\x64\x05\x7c\x01\xe5\x05\xb8\x00\x3f\x01\x02\x34\x4e\x74\x8d\x44\x10\x0d\xef\x1e\xef\x1e\xe3\x02\x7c\x01\xe5\x05\xb8\x00" +
+	"\x3f\x01\x02\x57\x7f\x09\x7a\xe7\x72\x00\x9c\xdf\x0c\xce\xdc\xc3\x02\x7c\x01\xe5\x05\xb8\x00\x3f\x01\x05\xe4\x00\x7c\x04\x02\x11" +
+	"\x05\x66\x04\x28\x93\x10\x1a\xf0\xed\xef\x02\x7f\x04\x66\x00\x41\x9d\x00\x66\x02\x7f\x07\x76\x03\x9f\x1d\x93\x02\x18\xbd\xf0\x50" +
+	"\x26\x60\x0d\xb0\x26\x60\x0b\xca\x94\x78\x7f\x06\x4a\xdf\x0e\xc5\x03\x66\x05\x66\x05\x22\x02\x00\x19\xca\x20\x38\x80\x3a\xb3\x9c" +
+	"\x02\x3e\x20\x3f\x04\x00\x1f\x02\x01\xc7\x03\x1f\x02\x01\xf0\x40\x06\xc0\x5e\x50\x03\xf0\x11\xe4\x05\x6e\x9d\x80\x09\xa0\x11\x04" +
+	"\x36\x40\x03\xe1\x2c\xee\x50\x6c\x70\x0b\x90\x05\xe1\x04\x6c\x01\xf0\x40\x1f\x04\x03\x1e\x40\x1f\x04\x00\x1f\x04\x03\x9a\x02\xc9" +
+	"\x00\x5e\x10\x23\xe1\x02\x3e\xce\x60\x32\x20\x53\x10\x10\x01\x4b\xdb\x81\x03\x4f\x0c\x78\xd2\x03\x9e\x10\x78\xe1\x07\x2f\x0a\x07" +
+	"\x7e\xf0\x90\x34\x26\xf0\x45\xf0\x90\x12\xf0\x4b\xb0\x16\xf0\x90\x06\xf0\x00\xe9\x02\x6f\x09\xc8\x00\xcd\x03\x6f\x0e\x10\x04\xf0" +
+	"\xb3\x14\xbf\x18\x01\x5d\xf2\xb2\x7f\x07\x02\x23\x05\x59\x7d\x7d\x7d\x59\x01\x12\x01\xaa\x00\x3f\x04\x00\xac\x00\x1f\x06\x00\x4f" +
+	"\x04\x00\x7f\x02\x00\x9f\x00\x19\xf0\x01\x7f\x02\x00\x4f\x04\x00\x1f\x06\x01\xac\x01\x3f\x04\x01\x9a\x02\x20\x11\x01\x7d\x01\x1e" +
+	"\x70\x19\xd0\x13\xf0\x40\x1f\x08\x01\xdb\x01\xbd\x01\xbc\x01\xdb\x01\xf0\x80\x03\xf0\x40\x09\xd0\x01\xe6\x00\x7d\x01\x11\x01\x02" +
+	"\x66\x02\x23\x00\x77\x00\x32\x3c\x98\x88\xc3\x01\x5e\xe5\x02\x4c\xcc\xc4\x00\x4b\x27\x72\xb5\x02\x77\x05\x33\x02\x04\xe5\x08\xe5" +
+	"\x08\xe5\x08\xe5\x03\x3a\xaa\xaf\x0c\xaa\xa7\x3a\xaa\xaf\x0c\xaa\xa7\x04\xe5\x08\xe5\x08\xe5\x08\xe5\x03\x2f\x08\x3f\x06\x6e\x18" +
+	"\x60\x00\x3f\x31\x44\x44\x4f\x05\x4f\x05\x03\xa3\x02\x4f\x01\x02\x9b\x03\xe6\x02\x3f\x02\x02\x8c\x03\xd7\x02\x2f\x03\x02\x7d\x03" +
+	"\xc8\x02\x1f\x04\x02\x6e\x03\xaa\x03\x73\x03\x01\x7b\xc8\x10\x2a\xe8\x7e\xc1\x00\x4f\x05\x01\x3f\x06\x00\x9e\x03\xcc\x00\xcb\x03" +
+	"\x9e\x00\xda\x03\x8f\x01\xea\x03\x7f\x01\xcb\x03\x8f\x00\x0b\xd0\x3a\xd0\x06\xf0\x30\x11\xe8\x00\x1e\xc3\x2a\xf0\x20\x12\xcf\x1e" +
+	"\x40\x41\x20\x30\x00\x47\xaa\x10\x13\xf0\xee\xf0\x20\x11\x30\x07\xf0\x20\x47\xf0\x20\x47\xf0\x20\x47\xf0\x20\x47\xf0\x20\x47\xf0" +
+	"\x20\x47\xf0\x20\x47\xf0\x20\x25\x59\xf0\x65\x30\x0f\x5b\x38\xbd\xb7\x10\x0b\xe9\x7a\xf0\xc0\x04\x03\x6f\x05\x04\x2f\x07\x04\x5f" +
+	"\x05\x03\x1d\xc0\x4b\xe2\x03\xae\x30\x3a\xe4\x03\xae\x40\x39\xf0\x95\x55\x53\xcf\x59\x39\xcd\xc9\x20\x06\xc9\x79\xee\x20\x43\xf0" +
+	"\x70\x5f\x08\x04\x7f\x03\x01\xad\xf0\xe4\x02\x56\x8e\xd2\x04\x1e\xa0\x5b\xd0\x5d\xb8\x63\x24\xbf\x05\x9e\xf2\xd5\x02\x23\x10\x20" +
+	"\x04\x6a\x60\x52\xef\x09\x05\xc8\xe9\x04\x7d\x1e\x90\x32\xe4\x00\xe9\x03\xb9\x01\xe9\x02\x6e\x10\x1e\x90\x11\xe6\x11\x1e\xa1\x00" +
+	"\x3f\x74\x14\x44\x44\xf0\xb4\x10\x5e\x90\x7e\x90\x10\x3a\xaa\xaa\x90\x04\xf0\xba\xaa\x90\x04\xf0\x30\x44\xf0\x30\x44\xf0\xaa\x95" +
+	"\x01\x4e\xaa\xdf\x0a\x00\x10\x38\xf0\x60\x5e\xa0\x5d\xb0\x42\xf0\x88\x63\x25\xde\x29\xf3\xc3\x01\x12\x31\x02\x01\x29\xcc\xa3\x01" +
+	"\x4e\xc8\x8b\x60\x01\xea\x05\x6f\x02\x05\xad\x28\xa8\x20\x1c\xde\xb9\xde\x30\x0d\xf0\x70\x11\xdc\x00\xbf\x01\x02\x8f\x01\x9f\x00" +
+	"\x37\xf0\x25\xf0\x30\x2a\xe0\x1d\xc3\x16\xf0\x80\x11\xbf\x28\x04\x13\x10\x20\x7a\xaa\xaa\xa8\x7a\xaa\xab\xf0\x90\x46\xf0\x40\x4c" +
+	"\xd0\x43\xf0\x70\x48\xf0\x10\x4e\xa0\x45\xf0\x50\x4b\xe0\x42\xf0\x80\x47\xf0\x20\x4d\xc0\x30\x00\x29\xcc\xa3\x01\x2e\xd7\x6c\xf0" +
+	"\x40\x08\xf0\x20\x11\xea\x00\x9f\x00\x3c\xb0\x04\xf0\x60\x14\xf0\x60\x15\xdd\xde\x60\x11\xce\x88\xcd\x30\x09\xe1\x02\xcc\x00\xdb" +
+	"\x03\x8f\x01\xcc\x03\xae\x00\x7f\x08\x21\x6f\x09\x01\x8e\xf2\x91\x03\x23\x10\x20\x00\x29\xcb\x70\x12\xed\x77\xeb\x00\xae\x10\x13" +
+	"\xf0\x5d\xa0\x3d\xbe\xa0\x3d\xdb\xd0\x22\xf1\x4f\x0b\x45\xce\xf0\x00\x4c\xf0\xd8\xad\x05\xdb\x04\x4f\x05\x26\x33\x6e\xb0\x03\xef" +
+	"\x1e\x90\x32\x30\x30\x14\x22\xf0\x81\xb6\x0b\x2f\x08\x2f\x08\x14\x22\xf0\x81\xb6\x0b\x2f\x08\x3f\x06\x6e\x18\x60\x00\x09\x20\x62" +
+	"\x7d\xb0\x31\x6c\xf0\xe8\x30\x15\xaf\x0e\x94\x02\x3e\xf0\xa5\x05\x3e\xf0\xb5\x10\x64\xae\xea\x40\x61\x6b\xf0\xe9\x30\x62\x7c\xb0" +
+	"\x91\x14\x44\x44\x44\x44\x34\xf8\xb0\x01\x11\x11\x11\x11\x01\x11\x11\x11\x11\x11\x4f\x8b\x14\x44\x44\x44\x44\x30\x11\x08\x4e\xa4" +
+	"\x06\x16\xbf\x0e\x83\x06\x27\xcf\x0d\x72\x06\x38\xdf\x09\x05\x39\xef\x08\x02\x28\xdf\x0c\x61\x00\x17\xcf\x0d\x82\x03\x4e\x94\x06" +
+	"\x10\x90\x29\xcc\xa2\x00\xdb\x79\xed\x13\x02\x6f\x04\x03\x6f\x03\x02\x2e\xa0\x21\xdc\x10\x2a\xd1\x03\xd9\x04\xb8\x0b\xe9\x04\xea" +
+	"\x02\x05\x23\x20\x74\xbe\xed\xf0\xd7\x04\x8e\x82\x02\x5c\xc1\x01\x6d\x20\x6a\xb0\x02\xe3\x01\x6a\x83\x73\x1e\x57\xa0\x18\xe8\x8d" +
+	"\xe5\x00\x7a\xa5\x00\x1f\x04\x01\x3f\x05\x00\x4c\xd3\x00\x4f\x00\x3e\x50\x05\xcc\x40\x03\xf0\x10\x2f\x05\x00\x99\x97\x01\xd8\x01" +
+	"\x8f\x05\x5e\x26\xd1\x00\x4e\xee\xbc\xec\x30\x1b\x90\x11\x42\x00\x32\x03\x2e\x91\x04\x61\x03\x1a\xea\x76\x9d\xc3\x05\x47\xab\x85" +
+	"\x03\x03\x6a\x50\x7d\xf0\xc0\x64\xf0\x8f\x03\x05\x9d\x00\xe9\x04\x1e\x80\x08\xe0\x46\xf0\x20\x03\xf0\x50\x3c\xc0\x2c\xb0\x22\xf0" +
+	"\x95\x55\xaf\x02\x01\x8f\x67\x01\xea\x04\xbd\x00\x4f\x05\x04\x5f\x03\xae\x05\x1e\x90\x4a\xaa\xa9\x50\x16\xf0\xa9\xac\xf0\xb0\x06" +
+	"\xf0\x20\x28\xf0\x36\xf0\x20\x24\xf0\x56\xf0\x20\x11\xaf\x01\x6f\x0d\xdd\xf0\xd3\x00\x6f\x08\x66\x8d\xc1\x6f\x02\x02\x2f\x09\x6f" +
+	"\x02\x03\xdc\x6f\x02\x02\x1e\xa6\xf0\x64\x56\xcf\x04\x6f\x3e\xb4\x00\x02\x18\xad\xb9\x20\x24\xee\x97\x9c\xf0\x40\x01\xec\x10\x35" +
+	"\x40\x09\xf0\x30\x7d\xc0\x8f\x0a\x07\x1f\x09\x08\xdb\x08\xbe\x10\x74\xf0\x80\x41\x20\x19\xf0\xa4\x23\x7e\x50\x25\xdf\x2e\x71\x04" +
+	"\x13\x10\x20\x4a\xaa\x97\x50\x36\xf0\xa9\xac\xf0\xd6\x01\x6f\x02\x02\x2b\xf0\x40\x06\xf0\x20\x31\xdd\x00\x6f\x02\x04\x8f\x02\x6f" +
+	"\x02\x04\x6f\x04\x6f\x02\x04\x5f\x05\x6f\x02\x04\x7f\x03\x6f\x02\x04\xbf\x00\x06\xf0\x20\x36\xf0\x70\x06\xf0\x64\x57\xcf\x0b\x01" +
+	"\x6f\x2e\xca\x40\x20\x4a\xaa\xaa\xaa\x00\x6f\x0b\xaa\xaa\x90\x06\xf0\x20\x56\xf0\x20\x56\xf0\x20\x56\xf0\xed\xdd\xd9\x00\x6f\x08" +
+	"\x77\x77\x50\x06\xf0\x20\x56\xf0\x20\x56\xf0\x20\x56\xf0\x65\x55\x55\x00\x6f\x61\x4a\xaa\xaa\xa3\x6f\x0b\xaa\xaa\x36\xf0\x20\x46" +
+	"\xf0\x20\x46\xf0\x20\x46\xf0\xee\xee\xb0\x06\xf0\x86\x66\x50\x06\xf0\x20\x46\xf0\x20\x46\xf0\x20\x46\xf0\x20\x46\xf0\x20\x40\x02" +
+	"\x17\xac\xca\x50\x34\xee\xa7\x8b\xf0\xb0\x11\xec\x20\x32\x80\x19\xf0\x30\x8d\xc0\x9f\x0a\x03\x11\x11\x00\x1f\x09\x02\x1f\x31\x00" +
+	"\xdb\x03\x33\x9f\x01\x00\xbe\x10\x47\xf0\x10\x04\xf0\x80\x47\xf0\x10\x19\xf0\xa4\x22\x4c\xf0\x10\x25\xdf\x3a\x30\x51\x32\x03\x4a" +
+	"\x20\x31\xa5\x6f\x02\x03\x2f\x07\x6f\x02\x03\x2f\x07\x6f\x02\x03\x2f\x07\x6f\x02\x03\x2f\x07\x6f\x0e\xdd\xdd\xef\x07\x6f\x08\x77" +
+	"\x77\x7f\x07\x6f\x02\x03\x2f\x07\x6f\x02\x03\x2f\x07\x6f\x02\x03\x2f\x07\x6f\x02\x03\x2f\x07\x6f\x02\x03\x2f\x07\x4a\x26\xf0\x26" +
+	"\xf0\x26\xf0\x26\xf0\x26\xf0\x26\xf0\x26\xf0\x26\xf0\x26\xf0\x26\xf0\x26\xf0\x20\x01\x4a\x20\x16\xf0\x20\x16\xf0\x20\x16\xf0\x20" +
+	"\x16\xf0\x20\x16\xf0\x20\x16\xf0\x20\x16\xf0\x20\x16\xf0\x20\x16\xf0\x20\x16\xf0\x20\x17\xf0\x20\x19\xf0\x00\x25\xeb\x00\xcf\x0c" +
+	"\x20\x02\x10\x20\x4a\x20\x21\x99\x16\xf0\x20\x11\xce\x40\x06\xf0\x20\x01\xce\x30\x16\xf0\x22\xdd\x20\x26\xf0\x5d\xd2\x03\x6f\x0e" +
+	"\xd1\x04\x6f\x0c\xf0\x50\x46\xf0\x3b\xf0\x50\x36\xf0\x20\x0b\xf0\x50\x26\xf0\x20\x1b\xf0\x50\x16\xf0\x20\x2b\xf0\x50\x06\xf0\x20" +
+	"\x3b\xf0\x50\x4a\x20\x46\xf0\x20\x46\xf0\x20\x46\xf0\x20\x46\xf0\x20\x46\xf0\x20\x46\xf0\x20\x46\xf0\x20\x46\xf0\x20\x46\xf0\x20" +
+	"\x46\xf0\x65\x55\x54\x6f\x5c\x4a\xa1\x03\x2a\xa3\x6f\x15\x03\x8f\x14\x6f\x0b\xb0\x3e\xbf\x04\x6f\x05\xf0\x20\x14\xf0\x6f\x04\x6f" +
+	"\x02\xd7\x01\xaa\x4f\x04\x6f\x02\x7d\x00\x1f\x04\x4f\x04\x6f\x02\x2f\x04\x6e\x00\x4f\x04\x6f\x02\x00\xb9\xc8\x00\x4f\x04\x6f\x02" +
+	"\x00\x5f\x13\x00\x4f\x04\x6f\x02\x00\x1b\xa0\x14\xf0\x46\xf0\x20\x54\xf0\x46\xf0\x20\x54\xf0\x40\x4a\x90\x31\xa4\x6f\x15\x02\x2f" +
+	"\x06\x6f\x0d\xd0\x22\xf0\x66\xf0\x5f\x06\x01\x2f\x06\x6f\x02\xad\x01\x2f\x06\x6f\x02\x3f\x07\x00\x2f\x06\x6f\x02\x00\xae\x12\xf0" +
+	"\x66\xf0\x20\x02\xf0\x72\xf0\x66\xf0\x20\x19\xe3\xf0\x66\xf0\x20\x11\xea\xf0\x66\xf0\x20\x28\xf1\x66\xf0\x20\x21\xef\x06\x02\x28" +
+	"\xbc\xa6\x04\x5e\xd9\x7a\xf0\xb1\x01\x1e\xc1\x02\x4f\x0a\x01\x9f\x03\x04\x8f\x03\x00\xdc\x05\x3f\x07\x00\xf0\xa0\x51\xf0\x91\xf0" +
+	"\x90\x6f\x0a\x00\xeb\x05\x2f\x08\x00\xbe\x10\x46\xf0\x50\x04\xf0\x80\x31\xdd\x02\x9f\x09\x32\x5d\xe4\x03\x6d\xf2\xb2\x06\x13\x04" +
+	"\x4a\xaa\x97\x20\x16\xf0\xa9\xae\xf0\x40\x06\xf0\x20\x11\xed\x00\x6f\x02\x02\x9f\x01\x6f\x02\x02\xaf\x00\x06\xf0\x20\x01\x6f\x0b" +
+	"\x00\x6f\x4b\x20\x06\xf0\x65\x42\x02\x6f\x02\x05\x6f\x02\x05\x6f\x02\x05\x6f\x02\x05\x02\x28\xbc\xa6\x04\x5e\xd9\x7a\xf0\xb1\x01" +
+	"\x1e\xc1\x02\x4f\x0a\x01\x9f\x03\x04\x8f\x03\x00\xdc\x05\x3f\x07\x00\xf0\xa0\x51\xf0\x91\xf0\x90\x6f\x0a\x00\xeb\x05\x2f\x08\x00" +
+	"\xbe\x10\x46\xf0\x50\x04\xf0\x80\x31\xdd\x02\x9f\x09\x32\x5d\xf0\x30\x36\xdf\x2d\x30\x61\x3b\xe3\x08\x1c\xe2\x09\x10\x10\x4a\xaa" +
+	"\x98\x20\x26\xf0\xa9\xae\xf0\x50\x16\xf0\x20\x11\xdd\x01\x6f\x02\x02\x9f\x01\x00\x6f\x02\x02\xbf\x00\x16\xf0\x54\x49\xf0\x80\x16" +
+	"\xf4\x80\x26\xf0\x31\x3a\xf0\x40\x16\xf0\x20\x2c\xd0\x16\xf0\x20\x24\xf0\x60\x06\xf0\x20\x3c\xd0\x06\xf0\x20\x34\xf0\x60\x00\x39" +
+	"\xcd\xb8\x30\x04\xf0\xd8\x78\xd8\x00\xbd\x10\x31\x00\xda\x06\xbe\x50\x53\xdf\x0e\xb7\x20\x21\x6a\xdf\x0e\x50\x53\xde\x10\x57\xf0" +
+	"\x30\x58\xf0\x2c\x84\x22\x6f\x0c\x00\x8d\xf3\xa1\x02\x13\x21\x02\xaa\xaa\xaa\xaa\xa8\xaa\xaa\xf0\xda\xaa\x80\x3e\xa0\x7e\xa0\x7e" +
+	"\xa0\x7e\xa0\x7e\xa0\x7e\xa0\x7e\xa0\x7e\xa0\x7e\xa0\x7e\xa0\x30\x6a\x04\x3a\x39\xf0\x04\x4f\x05\x9f\x00\x44\xf0\x59\xf0\x04\x4f" +
+	"\x05\x9f\x00\x44\xf0\x59\xf0\x04\x4f\x05\x9f\x00\x44\xf0\x59\xf0\x04\x4f\x05\x7f\x01\x03\x6f\x03\x5f\x05\x03\xaf\x01\x00\xce\x52" +
+	"\x27\xf0\x80\x12\xaf\x2e\x70\x41\x32\x03\x89\x06\x97\x6f\x03\x04\x4f\x06\x1f\x09\x04\xae\x10\x0a\xe0\x31\xe9\x01\x4f\x05\x02\x6f" +
+	"\x03\x02\xdb\x02\xcd\x03\x8f\x02\x00\x2f\x07\x03\x2f\x07\x00\x8f\x02\x04\xbd\x00\xdb\x05\x6f\x07\xf0\x50\x51\xef\x0e\x07\x9f\x09" +
+	"\x03\x4a\x20\x21\xa9\x03\x4a\x23\xf0\x60\x24\xf1\x10\x29\xf0\x01\xea\x02\x8c\xe5\x02\xdb\x01\xae\x02\xb8\xa9\x01\x1f\x08\x01\x6f" +
+	"\x02\x01\xf0\x47\xc0\x15\xf0\x40\x13\xf0\x60\x04\xf0\x13\xf0\x10\x09\xf0\x03\xea\x00\x8c\x01\xe5\x00\xcb\x03\xae\x00\xb8\x01\xb9" +
+	"\x1f\x08\x03\x6f\x03\xf0\x50\x17\xc5\xf0\x40\x33\xf0\xaf\x01\x01\x3f\x0a\xf0\x05\xef\x0c\x03\xef\x0b\x05\xaf\x09\x03\xbf\x08\x02" +
+	"\x00\x89\x04\x79\x10\x03\xf0\x70\x24\xf0\x70\x28\xf0\x30\x01\xdb\x04\xdc\x00\x9e\x20\x43\xf0\xaf\x07\x06\x9f\x0b\x07\xcf\x0c\x06" +
+	"\x8f\x06\xf0\x70\x43\xf0\x80\x08\xf0\x30\x3c\xc0\x11\xdc\x02\x8f\x03\x02\x4f\x07\x00\x3f\x08\x04\x9f\x02\x89\x04\x1a\x64\xf0\x70" +
+	"\x3a\xe1\x00\x9f\x02\x01\x5f\x05\x01\x1d\xc0\x01\xea\x03\x3f\x07\xae\x10\x48\xf1\x50\x6e\xb0\x7e\xa0\x7e\xa0\x7e\xa0\x7e\xa0\x7e" +
+	"\xa0\x30\x1a\xaa\xaa\xaa\xaa\x11\xaa\xaa\xaa\xaf\x0e\x10\x69\xf0\x40\x66\xf0\x70\x63\xf0\xa0\x62\xec\x10\x6c\xe2\x06\x9f\x04\x06" +
+	"\x6f\x07\x06\x4f\x0a\x06\x2e\xe5\x55\x55\x55\x14\xf8\x40\x12\x22\x9f\x0e\xa9\xc0\x19\xc0\x19\xc0\x19\xc0\x19\xc0\x19\xc0\x19\xc0" +
+	"\x19\xc0\x19\xc0\x19\xc0\x19\xc0\x19\xc0\x19\xf1\xa1\x22\x10\x94\x03\xaa\x03\x5e\x10\x21\xf0\x50\x3b\x90\x36\xe0\x31\xf0\x30\x3c" +
+	"\x80\x37\xd0\x33\xf0\x20\x3d\x70\x38\xc0\x34\xf0\x10\x37\x20\x12\x22\x6e\xf0\xd0\x19\xd0\x19\xd0\x19\xd0\x19\xd0\x19\xd0\x19\xd0" +
+	"\x19\xd0\x19\xd0\x19\xd0\x19\xd0\x19\xd0\x19\xd7\xf1\xd1\x22\x10\x03\x4a\x80\x64\xec\xf0\x90\x43\xea\x00\x5f\x09\x02\x3e\x90\x24" +
+	"\xe8\x00\x19\x70\x43\xa4\x15\x55\x55\x55\x51\x2c\xcc\xcc\xcc\xc2\x4c\x20\x18\xc0\x2a\x90\x22\x29\xde\xd8\x10\x05\x96\x47\xea\x05" +
+	"\x4f\x02\x00\x16\x78\x9f\x04\x4e\xda\x99\xf0\x5d\xa0\x22\xf0\x5f\x07\x02\x7f\x05\xcc\x31\x5d\xf0\x53\xdf\x1d\x4f\x05\x01\x32\x03" +
+	"\x12\x06\x8e\x06\x8e\x06\x8e\x06\x8e\x2a\xec\x50\x18\xed\x74\x9f\x05\x00\x8f\x06\x02\xbd\x00\x8f\x01\x02\x5f\x02\x8e\x03\x4f\x04" +
+	"\x8f\x00\x35\xf0\x28\xf0\x40\x29\xe0\x08\xf0\xc3\x15\xf0\x80\x08\xe5\xef\x19\x05\x21\x02\x01\x19\xde\xc7\x00\x2e\xd6\x47\x90\x0a" +
+	"\xe1\x04\xe9\x04\x1f\x07\x05\xf0\x80\x5c\xc0\x54\xf0\xa2\x13\x60\x14\xdf\x2a\x03\x23\x10\x00\x06\x22\x06\xbb\x06\xbb\x06\xbb\x01" +
+	"\x4b\xeb\x4b\xb0\x03\xf0\xb5\x6d\xdb\x00\xbd\x02\x3f\x0b\x00\xe8\x03\xdb\x1f\x06\x03\xcb\x00\xf0\x70\x3d\xb0\x0c\xb0\x22\xf0\xb0" +
+	"\x05\xf0\x71\x2b\xeb\x01\x7f\x27\xbb\x02\x13\x03\x01\x19\xde\xb3\x01\x2e\xc5\x5a\xf0\x30\x0a\xd1\x02\xca\x00\xe9\x22\x22\x9d\x1f" +
+	"\x6e\x00\xf0\x70\x6c\xc0\x63\xf0\xa2\x00\x25\x70\x14\xdf\x2e\x60\x31\x32\x01\x03\x22\x01\x7f\x1e\x00\x1f\x08\x10\x13\xf0\x30\x17" +
+	"\xcf\x0c\xb6\x38\xf0\x75\x30\x04\xf0\x30\x24\xf0\x30\x24\xf0\x30\x24\xf0\x30\x24\xf0\x30\x24\xf0\x30\x24\xf0\x30\x10\x01\x4b\xeb" +
+	"\x48\x80\x04\xf0\xa5\x6d\xdb\x00\xbc\x02\x3f\x0b\x00\xf0\x70\x3d\xb1\xf0\x60\x3b\xb0\x0f\x07\x03\xdb\x00\xbc\x02\x3f\x0b\x00\x4f" +
+	"\x0a\x45\xdd\xb0\x14\xce\xb4\xc9\x05\x1e\x70\x14\x01\x19\xf0\x20\x01\xf1\xdf\x0e\x50\x21\x35\x31\x01\x12\x05\x8e\x05\x8e\x05\x8e" +
+	"\x05\x8e\x2a\xdd\x60\x08\xec\x75\xaf\x04\x8f\x05\x02\xd9\x8f\x00\x3a\xb8\xe0\x3a\xc8\xe0\x3a\xc8\xe0\x3a\xc8\xe0\x3a\xc8\xe0\x3a" +
+	"\xc0\x12\x7e\x59\x01\x5b\x7e\x7e\x7e\x7e\x7e\x7e\x7e\x7e\x01\x12\x01\x7e\x01\x59\x05\x5b\x01\x7e\x01\x7e\x01\x7e\x01\x7e\x01\x7e" +
+	"\x01\x7e\x01\x7e\x01\x7e\x01\x8e\x01\xbc\x4e\xf0\x41\x41\x00\x12\x05\x8e\x05\x8e\x05\x8e\x05\x8e\x02\x3b\x78\xe0\x14\xe9\x00\x8e" +
+	"\x00\x6f\x07\x01\x8e\x8f\x06\x02\x8f\x1a\x03\x8e\x5f\x09\x02\x8e\x00\x4f\x09\x01\x8e\x01\x4e\xa0\x08\xe0\x24\xea\x12\x7e\x7e\x7e" +
+	"\x7e\x7e\x7e\x7e\x7e\x7e\x7e\x7e\x7e\x6a\x2a\xdb\x40\x05\xbe\xa1\x00\x8e\xc7\x5b\xe6\xc6\x6e\xb0\x08\xf0\x40\x11\xf0\xe1\x01\x6f" +
+	"\x01\x8f\x00\x3e\xa0\x24\xf0\x38\xe0\x3d\x80\x23\xf0\x48\xe0\x3d\x80\x23\xf0\x48\xe0\x3d\x80\x23\xf0\x48\xe0\x3d\x80\x23\xf0\x48" +
+	"\xe0\x3d\x80\x23\xf0\x40\x6a\x2a\xdd\x60\x08\xec\x75\xaf\x04\x8f\x05\x02\xd9\x8f\x00\x3a\xb8\xe0\x3a\xc8\xe0\x3a\xc8\xe0\x3a\xc8" +
+	"\xe0\x3a\xc8\xe0\x3a\xc0\x01\x3b\xdd\x91\x01\x3f\x0c\x56\xdd\x10\x0b\xd0\x22\xf0\x80\x0e\x80\x3b\xb1\xf0\x70\x3a\xd0\x0f\x08\x03" +
+	"\xbc\x00\xcc\x02\x1e\x90\x05\xf0\x81\x2b\xf0\x20\x16\xef\x1d\x40\x42\x20\x20\x6a\x2a\xec\x50\x18\xed\x74\x9f\x05\x00\x8f\x06\x02" +
+	"\xbd\x00\x8f\x01\x02\x5f\x02\x8e\x03\x4f\x04\x8f\x00\x35\xf0\x28\xf0\x40\x29\xe0\x08\xf0\xc3\x15\xf0\x80\x08\xe5\xef\x19\x01\x8e" +
+	"\x01\x21\x02\x8e\x06\x8e\x06\x34\x06\x01\x4b\xeb\x48\x80\x03\xf0\xb5\x6d\xdb\x00\xbd\x02\x3f\x0b\x00\xe8\x03\xdb\x1f\x06\x03\xcb" +
+	"\x00\xf0\x70\x3d\xb0\x0c\xb0\x22\xf0\xb0\x05\xf0\x71\x2b\xeb\x01\x7f\x27\xbb\x02\x13\x01\xbb\x06\xbb\x06\xbb\x06\x43\x6a\x2a\xd8" +
+	"\x8e\xd8\x54\x8f\x05\x02\x8f\x00\x38\xe0\x38\xe0\x38\xe0\x38\xe0\x38\xe0\x30\x00\x19\xde\xda\x10\x0b\xd5\x45\xa1\x00\xf0\x60\x5d" +
+	"\xd5\x10\x33\xcf\x1c\x50\x31\x5b\xf0\x40\x41\xf0\x81\x83\x11\x6f\x05\x1d\xf2\xe8\x02\x13\x21\x01\x00\x23\x03\x8e\x03\x8e\x02\x6d" +
+	"\xf0\xbb\xa3\xae\x55\x50\x08\xe0\x38\xe0\x38\xe0\x38\xe0\x37\xf0\x03\x5f\x07\x33\x01\x8d\xf0\xd0\x79\x03\x88\xac\x03\xba\xac\x03" +
+	"\xba\xac\x03\xba\xac\x03\xba\x9c\x03\xca\x8e\x02\x1e\xa4\xf0\x81\x3b\xea\x00\x8f\x1e\x6b\xa0\x11\x30\x30\x4b\x20\x38\xa1\xf0\x70" +
+	"\x21\xe8\x00\xad\x02\x5f\x02\x00\x4f\x03\x01\xbc\x02\xe9\x00\x2f\x06\x02\x8e\x00\x7f\x01\x02\x3f\x05\xda\x04\xcd\xf0\x40\x46\xf0" +
+	"\xd0\x20\x3b\x20\x14\xb5\x01\x1b\x40\x0f\x07\x01\x9f\x0b\x01\x5f\x01\x00\xbb\x01\xda\xe0\x19\xd0\x17\xe0\x02\xf0\x2f\x04\x00\xd9" +
+	"\x01\x3f\x03\x6d\x00\xb8\x2f\x05\x02\xe7\xa9\x00\x7b\x6f\x01\x02\xab\xe5\x00\x3f\x0b\xc0\x36\xf1\x10\x1e\xf0\x80\x33\xf0\xc0\x2b" +
+	"\xf0\x40\x10\x1a\x80\x22\xb6\x00\x5f\x06\x01\xcc\x02\x9e\x29\xe2\x03\xce\xf0\x60\x45\xf0\xc0\x41\xdd\xf0\x50\x3a\xe1\x9e\x20\x16" +
+	"\xf0\x40\x01\xcc\x00\x3f\x08\x02\x2e\x90\x4b\x20\x38\xa1\xe7\x02\x1e\x70\x09\xd0\x26\xf0\x20\x03\xf0\x40\x1c\xa0\x2c\xa0\x03\xf0" +
+	"\x40\x25\xf0\x29\xd0\x4e\x8e\x70\x48\xf1\x20\x42\xf0\xa0\x54\xf0\x40\x41\xbd\x04\xae\xf0\x40\x44\x52\x04\x1b\xbb\xbb\xb8\x16\x66" +
+	"\x67\xf0\xa0\x31\xcd\x10\x3b\xe2\x03\x9f\x03\x03\x6f\x05\x03\x4f\x07\x03\x2e\xb2\x22\x22\x5f\x5b\x03\x12\x02\x1b\xf1\x30\x16\xf0" +
+	"\x30\x38\xe0\x48\xd0\x49\xd0\x4a\xc0\x25\x8f\x06\x02\xce\xc2\x03\x1d\xb0\x49\xd0\x48\xd0\x48\xd0\x47\xe0\x43\xf0\xb8\x10\x23\x89" +
+	"\x20\x31\xf0\x5f\x05\xf0\x5f\x05\xf0\x5f\x05\xf0\x5f\x05\xf0\x5f\x05\xf0\x5f\x05\xf0\x5f\x05\xf0\x5b\x40\x21\x04\xef\x0d\x20\x32" +
+	"\xe9\x04\xbb\x04\xab\x04\xab\x04\x9d\x04\x4f\x0a\x61\x01\x1b\xec\x20\x18\xe2\x03\xac\x04\xab\x04\xab\x04\xca\x02\x8a\xf0\x60\x29" +
+	"\x84\x03\x02\x20\x52\x18\xef\x1c\x73\x49\xb4\xc5\x24\x9d\xf1\xb2\x10\x51\x02\x00\x5c\xc5\x00\x3e\x55\xe3\x69\x01\x96\x4d\x22\xd4" +
+	"\x00\x8e\xe8\x00"
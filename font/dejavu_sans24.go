@@ -0,0 +1,385 @@
+// Code generated by fontconv -in DejaVuSans.ttf -size 24 -ranges 32-126,0xB0 -name DejaVuSans24 -out dejavu_sans24.go; DO NOT EDIT.
+
+package font
+
+// DejaVuSans24 is DejaVu Sans 24px converted from DejaVuSans.ttf.
+var DejaVuSans24 = &Font{
+	Name:       "DejaVu Sans 24px",
+	Ascent:     23,
+	Descent:    6,
+	LineHeight: 28,
+	Glyphs:     dejaVuSans24Glyphs[:],
+	Kerning:    dejaVuSans24Kerning[:],
+	Bitmap:     dejaVuSans24Bitmap,
+	Compressed: true,
+	Fallback:   '?',
+}
+
+var dejaVuSans24Glyphs = [...]Glyph{
+	{' ', 0, 0, 0, 8, 0, 0},
+	{'!', 0, 3, 18, 10, 3, 18},
+	{'"', 27, 7, 7, 11, 2, 18},
+	{'#', 61, 18, 18, 20, 1, 18},
+	{'$', 175, 12, 23, 15, 2, 19},
+	{'%', 288, 21, 19, 23, 1, 18},
+	{'&', 448, 17, 19, 19, 1, 18},
+	{'\'', 559, 3, 7, 7, 2, 18},
+	{'(', 573, 6, 23, 9, 2, 19},
+	{')', 642, 6, 23, 9, 2, 19},
+	{'*', 710, 12, 12, 12, 0, 18},
+	{'+', 763, 16, 16, 20, 2, 16},
+	{',', 822, 5, 6, 8, 1, 3},
+	{'-', 841, 7, 3, 9, 1, 8},
+	{'.', 850, 4, 3, 8, 2, 3},
+	{'/', 856, 8, 21, 8, 0, 18},
+	{'0', 918, 13, 19, 15, 1, 18},
+	{'1', 1017, 12, 18, 15, 2, 18},
+	{'2', 1076, 12, 18, 15, 1, 18},
+	{'3', 1144, 13, 19, 15, 1, 18},
+	{'4', 1223, 13, 18, 15, 1, 18},
+	{'5', 1310, 13, 19, 15, 1, 18},
+	{'6', 1390, 13, 19, 15, 1, 18},
+	{'7', 1489, 12, 18, 15, 2, 18},
+	{'8', 1550, 13, 19, 15, 1, 18},
+	{'9', 1656, 13, 19, 15, 1, 18},
+	{':', 1756, 4, 13, 8, 2, 13},
+	{';', 1772, 5, 16, 8, 1, 13},
+	{'<', 1804, 16, 13, 20, 2, 14},
+	{'=', 1858, 16, 7, 20, 2, 11},
+	{'>', 1896, 16, 13, 20, 2, 14},
+	{'?', 1951, 10, 18, 13, 1, 18},
+	{'@', 2007, 22, 22, 24, 1, 17},
+	{'A', 2193, 17, 18, 16, 0, 18},
+	{'B', 2292, 13, 18, 16, 2, 18},
+	{'C', 2396, 15, 19, 17, 1, 18},
+	{'D', 2477, 15, 18, 18, 2, 18},
+	{'E', 2575, 12, 18, 15, 2, 18},
+	{'F', 2639, 11, 18, 14, 2, 18},
+	{'G', 2705, 16, 19, 19, 1, 18},
+	{'H', 2807, 14, 18, 18, 2, 18},
+	{'I', 2899, 3, 18, 7, 2, 18},
+	{'J', 2935, 7, 23, 7, -2, 18},
+	{'K', 3006, 14, 18, 16, 2, 18},
+	{'L', 3107, 12, 18, 13, 2, 18},
+	{'M', 3159, 17, 18, 21, 2, 18},
+	{'N', 3307, 14, 18, 18, 2, 18},
+	{'O', 3428, 17, 19, 19, 1, 18},
+	{'P', 3533, 12, 18, 14, 2, 18},
+	{'Q', 3611, 17, 22, 19, 1, 18},
+	{'R', 3726, 14, 18, 17, 2, 18},
+	{'S', 3829, 13, 19, 15, 1, 18},
+	{'T', 3908, 16, 18, 15, -1, 18},
+	{'U', 3973, 14, 19, 18, 2, 18},
+	{'V', 4069, 17, 18, 16, 0, 18},
+	{'W', 4166, 23, 18, 24, 0, 18},
+	{'X', 4343, 16, 18, 16, 0, 18},
+	{'Y', 4446, 15, 18, 15, 0, 18},
+	{'Z', 4524, 15, 18, 16, 1, 18},
+	{'[', 4589, 5, 23, 9, 2, 19},
+	{'\\', 4654, 8, 21, 8, 0, 18},
+	{']', 4715, 6, 23, 9, 2, 19},
+	{'^', 4783, 16, 7, 20, 2, 18},
+	{'_', 4825, 14, 2, 12, -1, -4},
+	{'`', 4834, 6, 6, 12, 2, 20},
+	{'a', 4850, 12, 15, 15, 1, 14},
+	{'b', 4928, 12, 20, 15, 2, 19},
+	{'c', 5026, 11, 15, 13, 1, 14},
+	{'d', 5084, 13, 20, 15, 1, 19},
+	{'e', 5183, 13, 15, 15, 1, 14},
+	{'f', 5258, 9, 19, 8, 0, 19},
+	{'g', 5323, 13, 19, 15, 1, 14},
+	{'h', 5428, 12, 19, 15, 2, 19},
+	{'i', 5515, 3, 19, 7, 2, 19},
+	{'j', 5551, 6, 24, 7, -1, 19},
+	{'k', 5620, 12, 19, 14, 2, 19},
+	{'l', 5716, 3, 19, 7, 2, 19},
+	{'m', 5754, 20, 14, 23, 2, 14},
+	{'n', 5875, 12, 14, 15, 2, 14},
+	{'o', 5947, 13, 15, 15, 1, 14},
+	{'p', 6024, 12, 19, 15, 2, 14},
+	{'q', 6122, 13, 19, 15, 1, 14},
+	{'r', 6220, 8, 14, 10, 2, 14},
+	{'s', 6267, 11, 15, 13, 1, 14},
+	{'t', 6330, 9, 17, 9, 0, 17},
+	{'u', 6387, 11, 15, 15, 2, 14},
+	{'v', 6456, 14, 14, 14, 0, 14},
+	{'w', 6531, 18, 14, 20, 1, 14},
+	{'x', 6654, 14, 14, 14, 0, 14},
+	{'y', 6731, 14, 19, 14, 0, 14},
+	{'z', 6821, 11, 14, 13, 1, 14},
+	{'{', 6873, 10, 23, 15, 3, 19},
+	{'|', 6949, 3, 25, 8, 3, 19},
+	{'}', 6999, 10, 23, 15, 3, 19},
+	{'~', 7073, 16, 5, 20, 2, 10},
+	{'\u00b0', 7104, 8, 8, 12, 2, 18},
+}
+
+var dejaVuSans24Kerning = [...]Kern{
+	{'-', 'A', -1}, {'-', 'B', -1}, {'-', 'G', 1}, {'-', 'J', 1},
+	{'-', 'O', 1}, {'-', 'Q', 1}, {'-', 'T', -2}, {'-', 'V', -1},
+	{'-', 'W', -1}, {'-', 'X', -1}, {'-', 'Y', -3}, {'-', 'v', -1},
+	{'A', '-', -1}, {'A', 'A', 1}, {'A', 'T', -2}, {'A', 'V', -2},
+	{'A', 'W', -1}, {'A', 'Y', -2}, {'A', 'f', -1}, {'A', 'v', -1},
+	{'A', 'w', -1}, {'A', 'y', -2}, {'B', 'V', -1}, {'B', 'W', -1},
+	{'B', 'Y', -1}, {'D', 'Y', -1}, {'F', '.', -4}, {'F', ':', -2},
+	{'F', 'A', -2}, {'F', 'a', -2}, {'F', 'e', -1}, {'F', 'i', -2},
+	{'F', 'o', -1}, {'F', 'r', -2}, {'F', 'u', -1}, {'F', 'y', -2},
+	{'G', 'T', -1}, {'G', 'Y', -1}, {'J', '-', -1}, {'K', '-', -3},
+	{'K', 'C', -1}, {'K', 'O', -1}, {'K', 'T', -2}, {'K', 'U', -1},
+	{'K', 'W', -1}, {'K', 'Y', -1}, {'K', 'e', -1}, {'K', 'o', -1},
+	{'K', 'u', -1}, {'K', 'y', -2}, {'L', 'A', 1}, {'L', 'O', -1},
+	{'L', 'T', -3}, {'L', 'U', -1}, {'L', 'V', -3}, {'L', 'W', -2},
+	{'L', 'Y', -3}, {'L', 'y', -2}, {'O', '-', 1}, {'O', '.', -1},
+	{'O', 'X', -2}, {'O', 'Y', -1}, {'P', '-', -1}, {'P', '.', -4},
+	{'P', 'A', -2}, {'P', 'Y', -1}, {'P', 'a', -1}, {'P', 'e', -1},
+	{'P', 'i', -1}, {'P', 'o', -1}, {'Q', '-', 1}, {'R', '-', -1},
+	{'R', '.', -1}, {'R', ':', -1}, {'R', 'A', -1}, {'R', 'C', -1},
+	{'R', 'T', -2}, {'R', 'V', -1}, {'R', 'W', -1}, {'R', 'Y', -2},
+	{'R', 'a', -1}, {'R', 'e', -1}, {'R', 'o', -1}, {'R', 'u', -1},
+	{'R', 'y', -1}, {'T', '-', -2}, {'T', '.', -3}, {'T', ':', -3},
+	{'T', 'A', -2}, {'T', 'C', -1}, {'T', 'a', -4}, {'T', 'c', -4},
+	{'T', 'e', -4}, {'T', 'i', -1}, {'T', 'o', -4}, {'T', 'r', -4},
+	{'T', 's', -4}, {'T', 'u', -4}, {'T', 'w', -4}, {'T', 'y', -4},
+	{'V', '-', -1}, {'V', '.', -3}, {'V', ':', -2}, {'V', 'A', -2},
+	{'V', 'a', -2}, {'V', 'e', -2}, {'V', 'i', -1}, {'V', 'o', -2},
+	{'V', 'u', -2}, {'V', 'y', -1}, {'W', '-', -1}, {'W', '.', -3},
+	{'W', ':', -1}, {'W', 'A', -1}, {'W', 'a', -2}, {'W', 'e', -1},
+	{'W', 'i', -1}, {'W', 'o', -1}, {'W', 'r', -1}, {'W', 'u', -1},
+	{'X', '-', -1}, {'X', 'C', -2}, {'X', 'O', -2}, {'X', 'e', -1},
+	{'Y', '-', -3}, {'Y', '.', -5}, {'Y', ':', -3}, {'Y', 'A', -2},
+	{'Y', 'C', -1}, {'Y', 'O', -1}, {'Y', 'a', -3}, {'Y', 'e', -3},
+	{'Y', 'i', -1}, {'Y', 'o', -3}, {'Y', 'u', -3}, {'f', '-', -1},
+	{'f', '.', -2}, {'f', ':', -1}, {'k', 'e', -1}, {'k', 'o', -1},
+	{'k', 'u', -1}, {'k', 'y', -1}, {'o', 'x', -1}, {'r', '-', -2},
+	{'r', '.', -2}, {'r', 'c', -1}, {'r', 'e', -1}, {'r', 'o', -1},
+	{'r', 'x', -1}, {'v', '-', -1}, {'v', '.', -2}, {'v', ':', -1},
+	{'w', '.', -2}, {'w', ':', -1}, {'x', 'e', -1}, {'x', 'o', -1},
+	{'y', '.', -3}, {'y', ':', -2},
+}
+
+// 7138 bytes
+const dejaVuSans24Bitmap = "" +
+	"\x38\x86\xf1\x6f\x16\xf1\x6f\x16\xf1\x6f\x16\xf1\x5f\x14\xf0\xe4\xf0\xd3\xf0\xc1\x54\x05\x6f\x16\xf1\x6f\x10\x58\x20\x02\x86\xaf" +
+	"\x04\x00\x4f\x0b\xaf\x04\x00\x4f\x0b\xaf\x04\x00\x4f\x0b\xaf\x04\x00\x4f\x0b\xaf\x04\x00\x4f\x0b\xaf\x04\x00\x4f\x0b\x06\x14\x20" +
+	"\x24\x30\x99\xf0\x50\x13\xf0\xa0\x9c\xf0\x10\x17\xf0\x60\x81\xf0\xc0\x2b\xf0\x20\x84\xf0\x90\x2e\xd0\x54\x55\x5a\xf0\x95\x57\xf0" +
+	"\xc5\x55\x10\x1c\xfd\x40\x15\x77\x7f\x0d\x77\x7d\xf0\x77\x77\x20\x44\xf0\x90\x2e\xe0\x98\xf0\x50\x13\xf0\xa0\x9c\xf0\x10\x17\xf0" +
+	"\x60\x41\xaa\xaa\xf1\xaa\xad\xf0\xba\xa9\x01\x2f\xde\x02\x22\x29\xf0\x62\x25\xf0\xa2\x22\x20\x5c\xf0\x10\x17\xf0\x60\x81\xf0\xd0" +
+	"\x2b\xf0\x20\x84\xf0\x90\x2e\xd0\x98\xf0\x50\x13\xf0\xa0\x60\x04\x40\x91\xf0\x20\x81\xf0\x20\x73\x6f\x08\x53\x03\x5d\xf5\xe3\x00" +
+	"\x4f\x19\x5f\x05\x69\xe3\x00\xbf\x07\x00\x1f\x02\x01\x11\x00\xef\x03\x00\x1f\x02\x04\xdf\x06\x00\x1f\x02\x04\x9f\x0e\x73\xf0\x20" +
+	"\x41\xbf\x3b\x73\x04\x49\xdf\x3a\x10\x41\xf0\x6a\xf1\xa0\x41\xf0\x20\x05\xf1\x10\x31\xf0\x20\x1e\xf0\x31\x02\x1f\x02\x00\x1f\x11" +
+	"\xe7\x10\x01\xf0\x22\xbf\x0c\x00\xf2\xdb\xf0\xcf\x1d\x20\x01\x6b\xde\xf0\xdb\x70\x61\xf0\x20\x81\xf0\x20\x81\xf0\x20\x81\x81\x04" +
+	"\x01\x5a\xb9\x30\x64\xc7\x04\x8f\x0d\xbe\xe4\x05\xde\x10\x32\xf0\xc1\x00\x3f\x0d\x04\x7f\x06\x04\x7f\x06\x02\xaf\x03\x02\x2e\xc0" +
+	"\x59\xf0\x40\x28\xf0\x50\x2a\xf0\x30\x59\xf0\x40\x28\xf0\x50\x14\xf0\x90\x67\xf0\x60\x2b\xf0\x30\x1d\xe1\x06\x2f\x0d\x10\x04\xf0" +
+	"\xc0\x17\xf0\x60\x87\xf0\xed\xf0\xe3\x00\x2e\xc0\x32\x05\x48\xa7\x20\x1a\xf0\x30\x01\xaf\x29\x09\x4f\x09\x01\xbf\x09\x5b\xf0\x90" +
+	"\x8d\xe1\x00\x4f\x0b\x02\xdf\x02\x06\x7f\x06\x01\x7f\x06\x02\x8f\x05\x05\x2e\xc0\x29\xf0\x40\x26\xf0\x70\x5a\xf0\x30\x27\xf0\x50" +
+	"\x27\xf0\x50\x44\xf0\x90\x35\xf0\xa0\x2c\xf0\x30\x4d\xe1\x04\xcf\x07\x38\xf0\xa0\x47\xf0\x60\x52\xcf\x2b\x10\x45\x50\x82\x42\x02" +
+	"\x03\x18\xac\xa8\x30\x84\xef\x53\x06\x1e\xf0\xb3\x13\x7d\x30\x65\xf0\xe1\x0c\x7f\x0c\x0d\x4f\x12\x0d\xcf\x0b\x0d\xaf\x1a\x0b\xaf" +
+	"\x0d\xf1\xa0\x56\x61\x00\x7f\x0d\x14\xf1\xa0\x33\xf0\xe0\x01\xef\x05\x01\x5f\x19\x02\x5f\x0a\x00\x5f\x11\x02\x5f\x19\x01\xaf\x06" +
+	"\x00\x7f\x0d\x04\x5f\x19\x2f\x0d\x10\x05\xf1\x05\x6f\x1d\xf0\x50\x12\xf1\x70\x56\xf1\xd0\x39\xf1\x60\x32\xbf\x28\x03\xcf\x1d\xa9" +
+	"\xcf\x1c\x8f\x18\x03\x7e\xf3\xd6\x01\x7f\x17\x04\x24\x41\x07\x58\x2a\xf0\x4a\xf0\x4a\xf0\x4a\xf0\x4a\xf0\x4a\xf0\x40\x02\x23\x10" +
+	"\x2c\xf0\x20\x16\xf0\x90\x11\xef\x01\x01\x6f\x0b\x02\xcf\x05\x01\x2f\x11\x01\x6f\x0c\x02\x9f\x09\x02\xcf\x07\x02\xdf\x06\x02\xef" +
+	"\x05\x02\xdf\x06\x02\xcf\x07\x02\x9f\x09\x02\x6f\x0c\x02\x2f\x11\x02\xbf\x05\x02\x5f\x0b\x03\xdf\x02\x02\x6f\x09\x03\xcf\x02\x02" +
+	"\x13\x10\x33\x03\xbf\x03\x02\x3f\x0b\x03\xbf\x05\x02\x5f\x0b\x02\x1e\xf0\x20\x2a\xf0\x70\x27\xf0\xb0\x24\xf0\xe0\x22\xf1\x20\x11" +
+	"\xf1\x30\x2f\x14\x01\x1f\x13\x01\x2f\x12\x01\x4f\x0e\x02\x7f\x0b\x02\xaf\x07\x01\x1e\xf0\x20\x15\xf0\xb0\x2b\xf0\x40\x13\xf0\xb0" +
+	"\x2b\xf0\x30\x23\x20\x30\x04\x88\x09\xaa\x05\x82\x01\xaa\x01\x28\x00\x1c\xf0\x70\x0a\xa0\x07\xf0\xc1\x01\x5d\xdc\xcd\xd5\x05\x8f" +
+	"\x18\x05\x19\xf0\xee\xf0\x91\x01\x17\xec\x3a\xa3\xce\x71\x1d\x60\x1a\xa0\x16\xd1\x04\xaa\x09\xaa\x09\x11\x04\x06\x11\x0d\xef\x01" +
+	"\x0c\xef\x01\x0c\xef\x01\x0c\xef\x01\x0c\xef\x01\x0c\xef\x01\x05\x38\x88\x88\x8e\xf0\x88\x88\x88\x47\xfd\x83\x77\x77\x77\xef\x07" +
+	"\x77\x77\x74\x06\xef\x01\x0c\xef\x01\x0c\xef\x01\x0c\xef\x01\x0c\xef\x01\x0c\xef\x01\x05\x00\x3f\x14\x00\x3f\x14\x00\x5f\x0e\x10" +
+	"\x08\xf0\x80\x1c\xe1\x00\x1c\x70\x10\x78\x88\x88\x4c\xf4\x75\x66\x66\x63\x6f\x11\x7f\x11\x7f\x11\x05\x77\x04\x3f\x0c\x04\x8f\x07" +
+	"\x04\xdf\x03\x03\x2f\x0d\x04\x7f\x08\x04\xbf\x04\x03\x1f\x0e\x04\x6f\x09\x04\xaf\x05\x04\xef\x01\x03\x5f\x0b\x04\x9f\x06\x04\xef" +
+	"\x01\x03\x3f\x0c\x04\x8f\x07\x04\xdf\x02\x03\x2f\x0d\x04\x7f\x08\x04\xcf\x03\x04\x34\x05\x03\x6a\xca\x71\x04\x1c\xf4\xe3\x03\xcf" +
+	"\x0e\x62\x5c\xf0\xe2\x01\x5f\x13\x02\x1d\xf0\x90\x1c\xf0\xa0\x46\xf1\x10\x0f\x16\x04\x2f\x14\x3f\x13\x05\xdf\x07\x5f\x11\x05\xcf" +
+	"\x08\x6f\x10\x6b\xf0\x96\xf1\x06\xbf\x0a\x5f\x11\x05\xcf\x09\x4f\x12\x05\xdf\x08\x2f\x14\x05\xef\x06\x00\xef\x08\x04\x4f\x12\x00" +
+	"\x9f\x0d\x04\x9f\x0d\x01\x2f\x19\x02\x5f\x16\x02\x6f\x1d\x9c\xf1\xa0\x46\xdf\x2e\x80\x73\x53\x10\x30\x01\x13\x67\x71\x03\x4c\xf4" +
+	"\x30\x35\xf1\xec\xf1\x30\x32\x52\x00\x2f\x13\x07\x2f\x13\x07\x2f\x13\x07\x2f\x13\x07\x2f\x13\x07\x2f\x13\x07\x2f\x13\x07\x2f\x13" +
+	"\x07\x2f\x13\x07\x2f\x13\x07\x2f\x13\x07\x2f\x13\x07\x2f\x13\x04\xf9\x10\x0f\x91\x01\x37\xac\xb9\x50\x21\xdf\x6c\x20\x02\xf0\xe9" +
+	"\x64\x48\xf1\xd1\x17\x10\x44\xf1\x70\x8c\xf0\xa0\x8a\xf0\xb0\x8e\xf0\x80\x75\xf1\x30\x62\xef\x09\x06\x1c\xf0\xc1\x05\x1c\xf0\xd2" +
+	"\x05\x1c\xf0\xd2\x05\x1b\xf0\xd2\x05\x1b\xf0\xe2\x06\xbf\x0e\x20\x6b\xf0\xe3\x06\x3f\x9d\x3f\x9d\x00\x15\x8a\xbb\xa7\x10\x3a\xf6" +
+	"\xe6\x02\x9b\x75\x34\x6d\xf1\x40\x81\xdf\x0a\x09\x8f\x0d\x09\x8f\x0c\x08\x1d\xf0\x70\x31\x55\x68\xef\x0a\x04\x2f\x46\x05\x19\x9b" +
+	"\xdf\x1a\x10\x84\xef\x09\x09\x5f\x12\x08\x1f\x14\x08\x2f\x13\x08\x6f\x11\x28\x20\x46\xf1\xa0\x03\xf1\xdc\xac\xef\x1d\x10\x01\xae" +
+	"\xf4\xe7\x10\x42\x45\x31\x04\x06\x58\x83\x07\x3f\x26\x07\xdf\x26\x06\x8f\x07\xef\x06\x05\x3f\x0c\x00\xef\x06\x05\xcf\x03\x00\xef" +
+	"\x06\x04\x7f\x08\x01\xef\x06\x03\x2f\x0d\x10\x1e\xf0\x60\x3c\xf0\x40\x2e\xf0\x60\x27\xf0\xa0\x3e\xf0\x60\x12\xee\x10\x3e\xf0\x60" +
+	"\x1b\xf0\x71\x11\x11\xef\x07\x11\xcf\xae\xbd\xdd\xdd\xdd\xf1\xed\xc0\x7e\xf0\x60\x9e\xf0\x60\x9e\xf0\x60\x9e\xf0\x60\x10\x00\x38" +
+	"\x88\x88\x88\x87\x02\x6f\x7d\x02\x6f\x0d\x88\x88\x88\x70\x26\xf0\xc0\x96\xf0\xc0\x96\xf0\xc0\x96\xf0\xc6\x87\x52\x04\x6f\x68\x10" +
+	"\x26\xea\x87\x9d\xf1\xc0\x21\x10\x47\xf1\x70\x9a\xf0\xd0\x95\xf1\x09\x4f\x12\x08\x6f\x10\x9c\xf0\xc0\x02\x82\x03\x2a\xf1\x50\x02" +
+	"\xf1\xdb\xac\xf2\x90\x11\xbe\xf4\xc4\x05\x34\x53\x10\x40\x04\x59\xbb\xa6\x20\x33\xdf\x59\x02\x3e\xf0\xd7\x43\x6a\x90\x2d\xf0\xb1" +
+	"\x07\x6f\x12\x08\xbf\x09\x08\x1f\x15\x00\x47\x75\x20\x23\xf1\x5c\xf3\xe7\x01\x4f\x1d\xf0\xa6\x6b\xf1\x80\x05\xf2\x60\x38\xf1\x24" +
+	"\xf1\xc0\x41\xef\x07\x3f\x19\x05\xbf\x09\x1f\x18\x05\xaf\x0b\x00\xcf\x09\x05\xcf\x09\x00\x7f\x0d\x04\x2f\x16\x00\x1e\xf0\x90\x21" +
+	"\xbf\x0d\x10\x14\xf1\xd9\xad\xf1\x40\x34\xcf\x3c\x30\x62\x44\x10\x30\x88\x88\x88\x88\x88\x82\xfa\x38\x88\x88\x88\x8d\xf0\xd0\x71" +
+	"\xef\x07\x07\x6f\x11\x07\xcf\x0a\x07\x2f\x15\x07\x8f\x0e\x08\xef\x08\x07\x5f\x12\x07\xbf\x0b\x07\x1f\x16\x07\x7f\x0e\x10\x7d\xf0" +
+	"\x90\x74\xf1\x30\x79\xf0\xd0\x71\xef\x07\x07\x6f\x11\x05\x02\x28\xac\xa9\x30\x47\xf6\xa0\x25\xf1\xa4\x13\x8f\x19\x01\xbf\x0c\x04" +
+	"\x8f\x0e\x01\xdf\x07\x04\x3f\x12\x00\xdf\x07\x04\x3f\x11\x00\x8f\x0c\x04\x9f\x0c\x01\x1b\xf0\xc5\x34\xaf\x0e\x30\x37\xf4\x91\x03" +
+	"\x8e\xf0\xdb\xcf\x1a\x10\x18\xf0\xe4\x02\x2c\xf0\xc0\x01\xf1\x60\x42\xf1\x54\xf1\x20\x5d\xf0\x85\xf1\x10\x5c\xf0\x82\xf1\x50\x41" +
+	"\xf1\x60\x0d\xf0\xd2\x02\x1b\xf0\xe2\x00\x3f\x2b\x9a\xef\x16\x02\x3b\xf4\xc4\x05\x13\x53\x10\x30\x02\x27\xac\xa6\x05\x7e\xf4\xd2" +
+	"\x02\x5f\x1a\x32\x4d\xf0\xd1\x00\x1d\xf0\xa0\x31\xdf\x07\x00\x3f\x12\x04\x7f\x0e\x00\x6f\x10\x54\xf1\x26\xf0\xe0\x54\xf1\x64\xf1" +
+	"\x10\x46\xf1\x72\xf1\x70\x4c\xf1\x80\x08\xf0\xe5\x01\x19\xf2\x80\x01\xcf\x1e\xdf\x1b\xdf\x07\x02\x7c\xef\x0d\x71\xf1\x60\x83\xf1" +
+	"\x20\x88\xf0\xd0\x82\xef\x06\x01\x23\x03\x3d\xf0\xc0\x25\xf0\xdb\xbd\xf1\xd2\x02\x4d\xf3\xe8\x10\x52\x45\x31\x04\x16\x62\x3f\x14" +
+	"\x3f\x14\x28\x82\x0f\x07\x3f\x14\x3f\x14\x3f\x14\x00\x16\x62\x00\x3f\x14\x00\x3f\x14\x00\x28\x82\x0f\x0e\x3f\x14\x00\x3f\x14\x00" +
+	"\x5f\x0e\x10\x08\xf0\x80\x1c\xe1\x00\x1c\x70\x10\x0c\x39\x70\x92\x7d\xf1\x80\x61\x6b\xf2\xe8\x20\x44\xaf\x2e\x94\x04\x39\xef\x2a" +
+	"\x51\x04\x4d\xf2\xb6\x10\x77\xf1\xe4\x0a\x4c\xf2\xc7\x10\x92\x8d\xf2\xb6\x10\x94\x9e\xf2\xa5\x0a\x5a\xf2\xe9\x30\x91\x6c\xf1\x80" +
+	"\xc2\x87\x6e\xee\xee\xee\xee\xee\xee\xe8\x7f\xd8\x00\x11\x11\x11\x11\x11\x11\x11\x10\xf1\x22\x22\x22\x22\x22\x22\x22\x17\xfd\x86" +
+	"\xdd\xdd\xdd\xdd\xdd\xdd\xdd\x70\x69\x40\xc7\xf1\xd8\x20\x92\x8d\xf2\xc6\x10\x94\x9e\xf2\xb5\x0a\x5a\xf2\xe9\x40\x91\x6b\xf2\xd5" +
+	"\x0a\x3d\xf1\x80\x71\x6c\xf2\xd5\x04\x15\xbf\x2e\x83\x04\x4a\xef\x1e\xa4\x04\x29\xef\x2b\x61\x06\x7f\x1c\x72\x09\x68\x30\xc0\x01" +
+	"\x48\xbc\xa6\x01\x2c\xf5\xc1\x4f\x0b\x63\x38\xf1\x93\x40\x49\xf0\xd0\x66\xf1\x06\xaf\x0c\x05\x6f\x15\x04\x5f\x17\x04\x5f\x17\x05" +
+	"\xef\x08\x05\x3f\x10\x65\xf0\xe0\x65\xf0\xd0\x62\x44\x0f\x00\x6f\x0e\x06\x6f\x10\x66\xf1\x03\x06\x38\xbc\xdc\xa6\x10\xa4\xcf\x2e" +
+	"\xce\xf2\x81\x07\x8f\x1a\x41\x02\x16\xcf\x0d\x20\x59\xf0\xc2\x08\x6f\x0d\x20\x37\xf0\xb1\x0a\x5f\x0c\x02\x1e\xd1\x02\x15\x86\x20" +
+	"\x03\x50\x18\xf0\x50\x19\xf0\x40\x24\xef\x37\xaf\x00\x11\xec\x01\xed\x02\x1e\xf0\x93\x38\xf2\x02\xaf\x00\x03\xf0\x80\x28\xf0\x80" +
+	"\x37\xf1\x02\x7f\x03\x5f\x06\x02\xbf\x02\x03\x1f\x10\x26\xf0\x46\xf0\x40\x2d\xf0\x05\xef\x00\x28\xf0\x25\xf0\x50\x2c\xf0\x10\x4e" +
+	"\xf0\x02\xbe\x00\x4f\x07\x02\xaf\x05\x03\x4f\x10\x14\xf0\x80\x01\xf0\xb0\x24\xf0\xd2\x01\x2c\xf1\x00\x4e\xd1\x01\xbf\x02\x02\x9f" +
+	"\x1c\xcf\x0c\xcf\x0d\xf0\xb2\x02\x4f\x0a\x03\x6b\xec\x81\xac\xa5\x05\xaf\x07\x0f\x02\x1d\xf0\x70\x94\x07\x2c\xf0\xb4\x05\x3b\xf0" +
+	"\x90\x71\x9f\x1e\xa8\x8b\xef\x1b\x20\x92\x8c\xf3\xd9\x30\xf2\x21\x08\x05\x28\x85\x0c\x8f\x1e\x0c\xdf\x25\x0a\x4f\x0e\x9f\x0a\x0a" +
+	"\xaf\x09\x3f\x11\x08\x1f\x13\x00\xcf\x07\x08\x6f\x0d\x01\x7f\x0c\x08\xcf\x07\x01\x1f\x13\x06\x3f\x12\x02\xbf\x09\x06\x8f\x0b\x03" +
+	"\x5f\x0e\x10\x5e\xf0\x60\x4e\xf0\x50\x45\xf1\x87\x77\x77\xcf\x0b\x04\xaf\xa2\x02\x1f\x19\x88\x88\x88\x8d\xf0\x70\x27\xf0\xe0\x77" +
+	"\xf0\xd0\x2d\xf0\x90\x72\xf1\x40\x03\xf1\x30\x8c\xf0\xa0\x09\xf0\xd0\x96\xf0\xe1\x58\x88\x88\x76\x40\x3a\xf7\xd5\x01\xaf\x0d\x77" +
+	"\x77\x9e\xf1\x40\x0a\xf0\xb0\x41\xdf\x0c\x00\xaf\x0b\x05\x7f\x0e\x00\xaf\x0b\x05\x6f\x0e\x00\xaf\x0b\x05\xbf\x0b\x00\xaf\x0c\x44" +
+	"\x45\x6c\xf0\xe3\x00\xaf\x7b\x20\x1a\xf0\xea\xaa\xab\xef\x0d\x30\x0a\xf0\xb0\x41\xaf\x0e\x1a\xf0\xb0\x51\xef\x07\xaf\x0b\x06\xcf" +
+	"\x0a\xaf\x0b\x06\xdf\x0a\xaf\x0b\x05\x2f\x17\xaf\x0b\x03\x15\xdf\x0e\x2a\xf1\xee\xef\x3e\x50\x0a\xf5\xdc\x81\x01\x04\x38\xac\xb9" +
+	"\x72\x04\x2b\xf7\x92\x01\x4e\xf0\xe9\x43\x34\x8e\xf0\x70\x01\xef\x0d\x20\x51\x97\x00\x9f\x12\x08\x11\xef\x09\x0a\x5f\x13\x0a\x7f" +
+	"\x11\x0a\x9f\x0e\x0b\x9f\x0d\x0b\x8f\x10\xb7\xf1\x20\xa3\xf1\x50\xbd\xf0\xd0\xb5\xf1\x70\x72\x40\x1a\xf1\xa1\x03\x17\xe7\x02\x9f" +
+	"\x2d\xba\xcf\x25\x03\x4b\xf4\xe9\x20\x61\x34\x42\x03\x58\x88\x87\x65\x30\x5a\xf7\xea\x20\x2a\xf0\xd7\x77\x89\xdf\x27\x01\xaf\x0b" +
+	"\x05\x3c\xf1\x60\x0a\xf0\xb0\x7c\xf1\x1a\xf0\xb0\x73\xf1\x7a\xf0\xb0\x8c\xf0\xba\xf0\xb0\x89\xf0\xda\xf0\xb0\x88\xf1\xaf\x0b\x08" +
+	"\x7f\x1a\xf0\xb0\x88\xf0\xea\xf0\xb0\x8a\xf0\xda\xf0\xb0\x71\xef\x09\xaf\x0b\x07\x7f\x14\xaf\x0b\x06\x5f\x1b\x00\xaf\x0b\x03\x25" +
+	"\xbf\x1d\x10\x0a\xf1\xee\xef\x49\x10\x1a\xf4\xec\xa6\x20\x30\x58\x88\x88\x88\x88\x83\xaf\x96\xaf\x0d\x88\x88\x88\x88\x3a\xf0\xb0" +
+	"\x8a\xf0\xb0\x8a\xf0\xb0\x8a\xf0\xb0\x8a\xf0\xc5\x55\x55\x55\x50\x0a\xf9\x1a\xf0\xea\xaa\xaa\xaa\xa1\xaf\x0b\x08\xaf\x0b\x08\xaf" +
+	"\x0b\x08\xaf\x0b\x08\xaf\x0b\x08\xaf\x0b\x08\xaf\x99\xaf\x99\x58\x88\x88\x88\x88\x3a\xf8\x6a\xf0\xd8\x88\x88\x88\x3a\xf0\xb0\x7a" +
+	"\xf0\xb0\x7a\xf0\xb0\x7a\xf0\xb0\x7a\xf0\xc5\x55\x55\x53\x00\xaf\x7a\x00\xaf\x0e\xaa\xaa\xaa\x60\x0a\xf0\xb0\x7a\xf0\xb0\x7a\xf0" +
+	"\xb0\x7a\xf0\xb0\x7a\xf0\xb0\x7a\xf0\xb0\x7a\xf0\xb0\x7a\xf0\xb0\x70\x04\x28\xab\xb9\x74\x05\x2b\xf7\xd7\x02\x4e\xf0\xe9\x53\x23" +
+	"\x7b\xf1\x30\x01\xef\x0d\x20\x64\xd3\x00\xaf\x0e\x20\x81\x11\xef\x08\x0b\x5f\x13\x0b\x7f\x10\xc9\xf0\xe0\x51\x22\x22\x21\x9f\x0d" +
+	"\x05\x9f\x49\x8f\x0e\x05\x7c\xcc\xef\x09\x7f\x11\x08\xbf\x09\x3f\x15\x08\xbf\x09\x00\xdf\x0b\x08\xbf\x09\x00\x6f\x17\x07\xbf\x09" +
+	"\x01\xaf\x19\x20\x43\xdf\x09\x02\x9f\x2c\xaa\xbd\xf1\xd5\x03\x4a\xf5\xd6\x07\x13\x44\x20\x40\x58\x50\x75\x85\xaf\x0b\x07\xaf\x0a" +
+	"\xaf\x0b\x07\xaf\x0a\xaf\x0b\x07\xaf\x0a\xaf\x0b\x07\xaf\x0a\xaf\x0b\x07\xaf\x0a\xaf\x0b\x07\xaf\x0a\xaf\x0c\x55\x55\x55\x55\xcf" +
+	"\x0a\xaf\xba\xaf\x0e\xaa\xaa\xaa\xaa\xdf\x0a\xaf\x0b\x07\xaf\x0a\xaf\x0b\x07\xaf\x0a\xaf\x0b\x07\xaf\x0a\xaf\x0b\x07\xaf\x0a\xaf" +
+	"\x0b\x07\xaf\x0a\xaf\x0b\x07\xaf\x0a\xaf\x0b\x07\xaf\x0a\xaf\x0b\x07\xaf\x0a\x58\x5a\xf0\xba\xf0\xba\xf0\xba\xf0\xba\xf0\xba\xf0" +
+	"\xba\xf0\xba\xf0\xba\xf0\xba\xf0\xba\xf0\xba\xf0\xba\xf0\xba\xf0\xba\xf0\xba\xf0\xba\xf0\xb0\x03\x58\x50\x3a\xf0\xb0\x3a\xf0\xb0" +
+	"\x3a\xf0\xb0\x3a\xf0\xb0\x3a\xf0\xb0\x3a\xf0\xb0\x3a\xf0\xb0\x3a\xf0\xb0\x3a\xf0\xb0\x3a\xf0\xb0\x3a\xf0\xb0\x3a\xf0\xb0\x3a\xf0" +
+	"\xb0\x3a\xf0\xb0\x3a\xf0\xb0\x3a\xf0\xb0\x3b\xf0\xa0\x3c\xf0\x80\x22\xf1\x61\x35\xdf\x0e\x00\x4f\x2e\x50\x03\xca\x82\x01\x58\x50" +
+	"\x65\x88\x3a\xf0\xb0\x57\xf1\x80\x0a\xf0\xb0\x48\xf1\x70\x1a\xf0\xb0\x39\xf1\x70\x2a\xf0\xb0\x2a\xf1\x60\x3a\xf0\xb0\x1b\xf1\x50" +
+	"\x4a\xf0\xb1\xbf\x0e\x40\x5a\xf0\xcc\xf0\xe4\x06\xaf\x33\x07\xaf\x0e\xf1\xb1\x06\xaf\x0b\x6f\x1b\x10\x5a\xf0\xb0\x06\xf1\xb1\x04" +
+	"\xaf\x0b\x01\x6f\x1b\x04\xaf\x0b\x02\x6f\x1b\x03\xaf\x0b\x03\x6f\x1b\x02\xaf\x0b\x04\x6f\x1b\x01\xaf\x0b\x05\x6f\x1b\x00\xaf\x0b" +
+	"\x06\x6f\x1b\x58\x50\x8a\xf0\xb0\x8a\xf0\xb0\x8a\xf0\xb0\x8a\xf0\xb0\x8a\xf0\xb0\x8a\xf0\xb0\x8a\xf0\xb0\x8a\xf0\xb0\x8a\xf0\xb0" +
+	"\x8a\xf0\xb0\x8a\xf0\xb0\x8a\xf0\xb0\x8a\xf0\xb0\x8a\xf0\xb0\x8a\xf0\xb0\x8a\xf9\x4a\xf9\x40\x58\x87\x07\x28\x88\x3a\xf2\x40\x68" +
+	"\xf2\x5a\xf2\xa0\x6d\xf2\x5a\xf0\xbe\xe1\x04\x4f\x0b\xef\x05\xaf\x0a\xaf\x06\x04\xaf\x05\xef\x05\xaf\x0a\x4f\x0b\x03\x1f\x0e\x1e" +
+	"\xf0\x5a\xf0\xa0\x0d\xf0\x20\x26\xf0\x90\x0e\xf0\x5a\xf0\xa0\x08\xf0\x80\x2c\xf0\x40\x0e\xf0\x5a\xf0\xa0\x02\xf0\xd0\x13\xf0\xd0" +
+	"\x1e\xf0\x5a\xf0\xa0\x1c\xf0\x40\x08\xf0\x70\x1e\xf0\x5a\xf0\xa0\x16\xf0\x90\x0e\xf0\x20\x1e\xf0\x5a\xf0\xa0\x11\xee\x5f\x0b\x02" +
+	"\xef\x05\xaf\x0a\x02\xaf\x0e\xf0\x50\x2e\xf0\x5a\xf0\xa0\x24\xf1\xe1\x02\xef\x05\xaf\x0a\x03\x9a\x70\x3e\xf0\x5a\xf0\xa0\xae\xf0" +
+	"\x5a\xf0\xa0\xae\xf0\x5a\xf0\xa0\xae\xf0\x50\x58\x85\x06\x58\x4a\xf2\x20\x5b\xf0\x9a\xf2\x90\x5b\xf0\x9a\xf0\xdf\x12\x04\xbf\x09" +
+	"\xaf\x0a\xbf\x0a\x04\xbf\x09\xaf\x0a\x3f\x13\x03\xbf\x09\xaf\x0a\x00\xaf\x0b\x03\xbf\x09\xaf\x0a\x00\x2f\x14\x02\xbf\x09\xaf\x0a" +
+	"\x01\x9f\x0c\x02\xbf\x09\xaf\x0a\x01\x2f\x15\x01\xbf\x09\xaf\x0a\x02\x9f\x0c\x01\xbf\x09\xaf\x0a\x02\x1e\xf0\x60\x0b\xf0\x9a\xf0" +
+	"\xa0\x38\xf0\xd0\x0b\xf0\x9a\xf0\xa0\x31\xef\x06\xbf\x09\xaf\x0a\x04\x7f\x0e\xbf\x09\xaf\x0a\x05\xdf\x29\xaf\x0a\x05\x6f\x29\xaf" +
+	"\x0a\x06\xdf\x19\x04\x49\xac\xa8\x30\x73\xdf\x6c\x20\x44\xef\x0e\x85\x25\x8f\x1e\x30\x21\xef\x0d\x20\x43\xef\x0d\x10\x1a\xf1\x20" +
+	"\x64\xf1\x80\x01\xf1\x90\x8b\xf0\xd0\x05\xf1\x30\x85\xf1\x37\xf1\x10\x83\xf1\x69\xf0\xe0\x91\xf1\x79\xf0\xd0\xaf\x18\x8f\x10\x92" +
+	"\xf1\x67\xf1\x20\x84\xf1\x53\xf1\x50\x87\xf1\x10\x0d\xf0\xd0\x71\xef\x0b\x01\x6f\x17\x06\x8f\x14\x02\xbf\x19\x03\x1a\xf1\x90\x31" +
+	"\xaf\x2c\x9c\xf2\x90\x66\xcf\x4b\x50\x92\x35\x31\x05\x58\x88\x87\x64\x10\x2a\xf6\xe8\x10\x0a\xf0\xd7\x77\x9d\xf1\x90\x0a\xf0\xb0" +
+	"\x4a\xf1\x3a\xf0\xb0\x41\xf1\x7a\xf0\xb0\x5d\xf0\x9a\xf0\xb0\x5e\xf0\x8a\xf0\xb0\x45\xf1\x5a\xf0\xb0\x21\x6e\xf0\xd1\xaf\x7e\x40" +
+	"\x0a\xf3\xed\xb8\x10\x1a\xf0\xb0\x8a\xf0\xb0\x8a\xf0\xb0\x8a\xf0\xb0\x8a\xf0\xb0\x8a\xf0\xb0\x8a\xf0\xb0\x80\x04\x49\xac\xa8\x30" +
+	"\x73\xdf\x6c\x20\x44\xef\x0e\x85\x25\x8f\x1e\x30\x21\xef\x0d\x20\x43\xef\x0d\x10\x1a\xf1\x20\x64\xf1\x80\x01\xf1\x90\x8b\xf0\xd0" +
+	"\x05\xf1\x30\x85\xf1\x37\xf1\x10\x83\xf1\x69\xf0\xe0\x91\xf1\x79\xf0\xd0\xaf\x18\x8f\x10\x92\xf1\x67\xf1\x20\x84\xf1\x53\xf1\x50" +
+	"\x87\xf1\x10\x0d\xf0\xd0\x71\xef\x0a\x01\x6f\x17\x06\x8f\x14\x02\xbf\x19\x03\x1a\xf1\x80\x31\xaf\x2c\x9c\xf2\x90\x66\xcf\x4e\x40" +
+	"\x92\x35\x7f\x18\x0d\x7f\x17\x0d\x8f\x15\x0d\x11\x10\x10\x58\x88\x87\x64\x10\x4a\xf7\x91\x02\xaf\x0d\x77\x78\xcf\x1a\x02\xaf\x0b" +
+	"\x04\x8f\x14\x01\xaf\x0b\x04\x1f\x17\x01\xaf\x0b\x05\xdf\x09\x01\xaf\x0b\x05\xf1\x80\x1a\xf0\xb0\x47\xf1\x40\x1a\xf0\xc5\x55\x7b" +
+	"\xf1\xa0\x2a\xf6\xe6\x03\xaf\x0d\x99\xac\xf1\xa0\x3a\xf0\xb0\x34\xef\x08\x02\xaf\x0b\x04\x6f\x13\x01\xaf\x0b\x05\xcf\x0b\x01\xaf" +
+	"\x0b\x05\x5f\x13\x00\xaf\x0b\x06\xcf\x0b\x00\xaf\x0b\x06\x5f\x13\xaf\x0b\x07\xcf\x0b\x02\x28\xac\xba\x84\x10\x29\xf7\xc0\x18\xf1" +
+	"\xa4\x23\x58\xdd\x00\x1f\x17\x06\x30\x04\xf1\x10\x85\xf1\x10\x83\xf1\x80\x9b\xf1\xc8\x41\x05\x2c\xf4\xc7\x10\x45\x9e\xf3\xe5\x06" +
+	"\x26\xaf\x22\x08\x5f\x19\x09\xbf\x0c\x09\xaf\x0d\x10\x8d\xf0\xa5\xd6\x10\x31\x9f\x15\x5f\x2d\xa9\xbe\xf1\x90\x01\x7b\xf5\xc5\x04" +
+	"\x12\x45\x31\x03\x18\x88\x88\x88\x88\x88\x88\x86\x1f\xdb\x18\x88\x88\x8e\xf0\xb8\x88\x88\x60\x6d\xf0\x80\xcd\xf0\x80\xcd\xf0\x80" +
+	"\xcd\xf0\x80\xcd\xf0\x80\xcd\xf0\x80\xcd\xf0\x80\xcd\xf0\x80\xcd\xf0\x80\xcd\xf0\x80\xcd\xf0\x80\xcd\xf0\x80\xcd\xf0\x80\xcd\xf0" +
+	"\x80\xcd\xf0\x80\x50\x78\x40\x77\x84\xef\x07\x07\xdf\x07\xef\x07\x07\xdf\x07\xef\x07\x07\xdf\x07\xef\x07\x07\xdf\x07\xef\x07\x07" +
+	"\xdf\x07\xef\x07\x07\xdf\x07\xef\x07\x07\xdf\x07\xef\x07\x07\xdf\x07\xef\x07\x07\xdf\x07\xef\x07\x07\xdf\x07\xdf\x08\x07\xef\x07" +
+	"\xcf\x09\x06\x1f\x16\xaf\x0b\x06\x3f\x14\x6f\x13\x05\x9f\x0e\x00\x1e\xf0\xc2\x03\x6f\x18\x01\x4e\xf1\xc9\xad\xf1\xc0\x33\xbf\x4d" +
+	"\x80\x61\x35\x43\x04\x58\x60\x92\x88\x17\xf1\x10\x89\xf0\xd0\x01\xf1\x60\x71\xef\x07\x01\xaf\x0c\x07\x5f\x11\x01\x4f\x12\x06\xbf" +
+	"\x0a\x03\xdf\x08\x05\x2f\x15\x03\x8f\x0d\x05\x7f\x0e\x04\x2f\x14\x04\xdf\x08\x05\xcf\x0a\x03\x4f\x13\x05\x6f\x11\x02\x9f\x0c\x06" +
+	"\x1e\xf0\x60\x11\xef\x06\x07\x9f\x0c\x01\x6f\x11\x07\x4f\x12\x00\xbf\x0a\x09\xdf\x08\x2f\x14\x09\x7f\x0d\x8f\x0d\x0a\x2f\x38\x0b" +
+	"\xbf\x22\x0b\x5f\x1b\x06\x18\x82\x05\x48\x82\x05\x48\x70\x0e\xf0\x70\x5b\xf1\x70\x5b\xf0\xb0\x0b\xf0\xa0\x5e\xf1\xa0\x5e\xf0\x70" +
+	"\x07\xf0\xe0\x43\xf0\xbe\xe0\x43\xf1\x30\x03\xf1\x30\x37\xf0\x7b\xf0\x30\x37\xf0\xe0\x2e\xf0\x60\x3a\xf0\x37\xf0\x70\x3a\xf0\xb0" +
+	"\x2b\xf0\xa0\x3e\xf0\x00\x3f\x0a\x03\xef\x07\x02\x7f\x0e\x02\x3f\x0b\x01\xee\x02\x3f\x13\x02\x3f\x13\x01\x7f\x08\x01\xbf\x03\x01" +
+	"\x7f\x0e\x04\xef\x06\x01\xaf\x04\x01\x7f\x06\x01\xaf\x0b\x04\xbf\x0a\x01\xef\x01\x01\x4f\x0a\x01\xef\x07\x04\x7f\x0e\x00\x3f\x0c" +
+	"\x03\xf0\xe0\x03\xf1\x30\x43\xf1\x26\xf0\x80\x3b\xf0\x36\xf0\xe0\x6e\xf0\x6a\xf0\x40\x38\xf0\x6a\xf0\xb0\x6b\xf0\xae\xf0\x10\x34" +
+	"\xf0\xae\xf0\x70\x67\xf2\xc0\x41\xf3\x30\x63\xf2\x80\x5c\xf1\xe0\x8e\xf1\x50\x58\xf1\xb0\x30\x00\x28\x82\x06\x38\x71\x01\xcf\x0b" +
+	"\x05\x1d\xf0\xa0\x23\xf1\x60\x48\xf0\xe1\x03\x7f\x0e\x20\x24\xf1\x50\x5c\xf0\xb0\x11\xdf\x0a\x06\x3f\x16\x00\x9f\x0e\x10\x77\xf0" +
+	"\xe6\xf1\x50\x9c\xf2\xa0\xa3\xf1\xe1\x0a\x9f\x1e\x20\x94\xf1\xdf\x0b\x08\x1d\xf0\x93\xf1\x60\x79\xf0\xd1\x00\x7f\x0e\x20\x54\xf1" +
+	"\x40\x2c\xf0\xb0\x41\xdf\x09\x03\x3f\x15\x03\x9f\x0d\x10\x48\xf0\xe1\x01\x4f\x14\x06\xcf\x0a\x00\x1d\xf0\x90\x73\xf1\x50\x77\x50" +
+	"\x87\x74\x6f\x13\x06\x7f\x0e\x10\x0a\xf0\xc0\x53\xf1\x50\x11\xef\x08\x04\xcf\x0a\x03\x5f\x13\x02\x8f\x0e\x10\x4a\xf0\xc0\x13\xf1" +
+	"\x50\x51\xef\x08\x00\xcf\x0a\x07\x5f\x1a\xf0\xe1\x08\xaf\x25\x09\x1e\xf0\xa0\xbd\xf0\x80\xbd\xf0\x80\xbd\xf0\x80\xbd\xf0\x80\xbd" +
+	"\xf0\x80\xbd\xf0\x80\xbd\xf0\x80\xbd\xf0\x80\x50\x58\x88\x88\x88\x88\x88\x88\x1a\xfc\x15\x88\x88\x88\x88\x88\xf1\xd0\xaa\xf0\xe3" +
+	"\x09\x7f\x15\x09\x4f\x18\x09\x2e\xf0\xb0\x91\xdf\x0d\x10\x9a\xf0\xe2\x09\x8f\x15\x09\x5f\x17\x09\x3e\xf0\xa0\x91\xdf\x0c\x10\x9b" +
+	"\xf0\xe2\x09\x8f\x14\x09\x5f\x17\x0a\xef\xc5\xef\xc5\x34\x44\x4e\xf3\xef\x08\x77\xef\x03\x01\xef\x03\x01\xef\x03\x01\xef\x03\x01" +
+	"\xef\x03\x01\xef\x03\x01\xef\x03\x01\xef\x03\x01\xef\x03\x01\xef\x03\x01\xef\x03\x01\xef\x03\x01\xef\x03\x01\xef\x03\x01\xef\x03" +
+	"\x01\xef\x03\x01\xef\x03\x01\xef\x09\x88\xef\x32\x33\x33\x78\x10\x4a\xf0\x50\x46\xf0\x90\x41\xf0\xe0\x5c\xf0\x40\x47\xf0\x80\x42" +
+	"\xf0\xd0\x5d\xf0\x20\x48\xf0\x70\x43\xf0\xc0\x5e\xf0\x10\x49\xf0\x60\x44\xf0\xb0\x5e\xf0\x10\x4a\xf0\x50\x46\xf0\x90\x41\xf0\xe0" +
+	"\x5b\xf0\x40\x47\xf0\x80\x42\xf0\xd0\x53\x40\x24\x44\x41\xaf\x34\x47\x7e\xf0\x40\x2d\xf0\x40\x2d\xf0\x40\x2d\xf0\x40\x2d\xf0\x40" +
+	"\x2d\xf0\x40\x2d\xf0\x40\x2d\xf0\x40\x2d\xf0\x40\x2d\xf0\x40\x2d\xf0\x40\x2d\xf0\x40\x2d\xf0\x40\x2d\xf0\x40\x2d\xf0\x40\x2d\xf0" +
+	"\x40\x2d\xf0\x40\x2d\xf0\x45\x88\xef\x04\xaf\x34\x23\x33\x31\x05\x38\x83\x0a\x2e\xf1\xe3\x08\x2d\xf0\xdc\xf0\xe3\x06\x2d\xf0\xc1" +
+	"\x00\xaf\x0e\x30\x42\xdf\x0a\x10\x29\xf0\xe3\x02\x1d\xf0\x90\x57\xf0\xe2\x00\x1d\xf0\x80\x76\xf0\xd2\x4f\xb4\x2a\xaa\xaa\xaa\xaa" +
+	"\xaa\xa2\x33\x10\x26\xf0\xc0\x38\xf0\x90\x3a\xf0\x50\x3b\xe2\x02\x13\x10\x02\x24\x65\x31\x03\x5d\xf4\xe5\x02\x9f\x0a\x86\x8b\xf1" +
+	"\x60\x13\x10\x46\xf0\xe1\x08\xcf\x04\x03\x13\x44\x4b\xf0\x60\x16\xdf\x68\x00\x9f\x1b\x87\x66\xcf\x08\x3f\x0e\x30\x4b\xf0\x86\xf0" +
+	"\xb0\x5d\xf0\x88\xf0\xa0\x44\xf1\x85\xf0\xe2\x02\x3d\xf1\x80\x0c\xf0\xe9\x8a\xed\xbf\x08\x00\x2b\xf3\xb2\x9f\x08\x02\x24\x42\x04" +
+	"\x34\x10\x8c\xf0\x50\x8c\xf0\x50\x8c\xf0\x50\x8c\xf0\x50\x8c\xf0\x50\x01\x56\x30\x3c\xf0\x57\xf3\xe5\x01\xcf\x0b\xf0\xc7\x7b\xf1" +
+	"\x60\x0c\xf1\x90\x39\xf0\xe1\xcf\x0e\x10\x4e\xf0\x7c\xf0\x90\x58\xf0\xac\xf0\x70\x56\xf0\xcc\xf0\x60\x55\xf0\xdc\xf0\x70\x57\xf0" +
+	"\xcc\xf0\x90\x59\xf0\xac\xf0\xe1\x03\x1e\xf0\x6c\xf1\xa0\x3a\xf0\xd0\x0c\xf0\xaf\x0d\x99\xdf\x15\x00\xcf\x05\x5e\xf2\xd4\x06\x35" +
+	"\x20\x30\x03\x13\x56\x41\x03\x8f\x58\x00\x1d\xf1\xa7\x79\xdb\x00\x9f\x0d\x30\x43\x2f\x15\x06\x6f\x0d\x07\x8f\x0b\x07\x9f\x09\x07" +
+	"\x8f\x0b\x07\x5f\x0d\x07\x2f\x16\x07\x8f\x0e\x40\x31\x40\x01\xbf\x1b\x98\xae\xb0\x27\xef\x3d\x70\x42\x44\x20\x10\x09\x44\x09\x1f" +
+	"\x11\x08\x1f\x11\x08\x1f\x11\x08\x1f\x11\x03\x36\x52\x00\x1f\x11\x01\x2d\xf3\x92\xf1\x10\x03\xef\x0d\x86\xaf\x0b\xf1\x10\x0b\xf0" +
+	"\xb1\x02\x5f\x21\x3f\x13\x04\xbf\x11\x6f\x0c\x05\x5f\x11\x8f\x0a\x05\x3f\x11\xaf\x09\x05\x2f\x11\x8f\x0a\x05\x3f\x11\x6f\x0c\x05" +
+	"\x5f\x11\x2f\x13\x04\xbf\x11\x00\xaf\x0c\x10\x27\xf2\x10\x02\xef\x0e\x98\xcf\x0a\xf1\x10\x12\xcf\x38\x2f\x11\x03\x14\x41\x04\x03" +
+	"\x13\x65\x20\x68\xf4\xb2\x02\x1c\xf0\xe9\x78\xdf\x0e\x20\x18\xf0\xc1\x03\xaf\x0a\x00\x2f\x12\x04\x1e\xf0\x25\xf0\xc0\x6c\xf0\x48" +
+	"\xf0\xeb\xbb\xbb\xbb\xef\x06\x9f\x1e\xee\xee\xee\xee\x78\xf0\xa0\x95\xf0\xd0\x92\xf1\x50\x97\xf0\xe4\x04\x28\x02\xbf\x1c\x98\xac" +
+	"\xf1\x03\x5d\xf4\xc7\x05\x14\x53\x10\x20\x04\x13\x33\x02\x2b\xf2\xe0\x2c\xf0\xd9\x88\x01\x3f\x0e\x10\x45\xf0\xc0\x31\x27\xf0\xc2" +
+	"\x22\x17\xf6\x54\x8b\xf0\xe8\x88\x30\x16\xf0\xc0\x56\xf0\xc0\x56\xf0\xc0\x56\xf0\xc0\x56\xf0\xc0\x56\xf0\xc0\x56\xf0\xc0\x56\xf0" +
+	"\xc0\x56\xf0\xc0\x56\xf0\xc0\x56\xf0\xc0\x30\x03\x36\x52\x01\x22\x02\x3d\xf3\x92\xf1\x10\x04\xf1\xc8\x6a\xf0\xbf\x11\x00\xcf\x0b" +
+	"\x10\x25\xf2\x14\xf1\x20\x4a\xf1\x16\xf0\xb0\x55\xf1\x18\xf0\xa0\x53\xf1\x19\xf0\x90\x52\xf1\x18\xf0\xa0\x54\xf1\x16\xf0\xd0\x56" +
+	"\xf1\x11\xef\x05\x04\xdf\x11\x00\x8f\x0e\x50\x11\xbe\xf1\x10\x01\xbf\x1e\xdf\x16\xf1\x10\x27\xbe\xea\x33\xf0\xe0\x95\xf0\xc0\x9c" +
+	"\xf0\x80\x11\x82\x02\x2a\xf0\xe1\x01\x1f\x1e\xce\xf1\xe5\x03\x7b\xde\xec\x92\x02\x34\x10\x8c\xf0\x50\x8c\xf0\x50\x8c\xf0\x50\x8c" +
+	"\xf0\x50\x8c\xf0\x50\x01\x56\x41\x02\xcf\x05\x5e\xf3\x60\x1c\xf0\xaf\x0c\x88\xcf\x14\x00\xcf\x18\x03\xaf\x0c\x00\xcf\x0c\x04\x3f" +
+	"\x10\x0c\xf0\x80\x41\xf1\x2c\xf0\x60\x5f\x13\xcf\x05\x05\xf1\x3c\xf0\x50\x5f\x13\xcf\x05\x05\xf1\x3c\xf0\x50\x5f\x13\xcf\x05\x05" +
+	"\xf1\x3c\xf0\x50\x5f\x13\xcf\x05\x05\xf1\x30\x34\x1b\xf0\x6b\xf0\x66\x83\x02\x12\x1b\xf0\x6b\xf0\x6b\xf0\x6b\xf0\x6b\xf0\x6b\xf0" +
+	"\x6b\xf0\x6b\xf0\x6b\xf0\x6b\xf0\x6b\xf0\x6b\xf0\x6b\xf0\x60\x02\x34\x10\x2b\xf0\x60\x2b\xf0\x60\x26\x83\x08\x12\x10\x2b\xf0\x60" +
+	"\x2b\xf0\x60\x2b\xf0\x60\x2b\xf0\x60\x2b\xf0\x60\x2b\xf0\x60\x2b\xf0\x60\x2b\xf0\x60\x2b\xf0\x60\x2b\xf0\x60\x2b\xf0\x60\x2b\xf0" +
+	"\x60\x2b\xf0\x60\x2b\xf0\x60\x2d\xf0\x40\x14\xf1\x25\xdf\x19\x00\x7f\x0c\x80\x10\x34\x10\x8c\xf0\x50\x8c\xf0\x50\x8c\xf0\x50\x8c" +
+	"\xf0\x50\x8c\xf0\x50\x41\x22\x1c\xf0\x50\x32\xcf\x0b\x1c\xf0\x50\x23\xdf\x0a\x01\xcf\x05\x01\x4e\xf0\x80\x2c\xf0\x50\x05\xf1\x70" +
+	"\x3c\xf0\x57\xf1\x50\x4c\xf0\xdf\x0e\x40\x5c\xf0\xef\x0e\x30\x5c\xf0\x6a\xf0\xe3\x04\xcf\x05\x00\xaf\x0e\x30\x3c\xf0\x50\x19\xf0" +
+	"\xe4\x02\xcf\x05\x02\x9f\x0e\x40\x1c\xf0\x50\x38\xf1\x40\x0c\xf0\x50\x48\xf1\x50\x34\x1b\xf0\x6b\xf0\x6b\xf0\x6b\xf0\x6b\xf0\x6b" +
+	"\xf0\x6b\xf0\x6b\xf0\x6b\xf0\x6b\xf0\x6b\xf0\x6b\xf0\x6b\xf0\x6b\xf0\x6b\xf0\x6b\xf0\x6b\xf0\x6b\xf0\x60\x22\x10\x01\x56\x51\x03" +
+	"\x36\x52\x02\xcf\x05\x6e\xf2\xe4\x00\x1b\xf3\x90\x1c\xf0\xaf\x0c\x88\xdf\x0e\x2d\xe9\x7a\xf1\x70\x0c\xf1\x70\x21\xef\x0e\xd2\x02" +
+	"\x7f\x0e\x00\xcf\x0c\x04\x8f\x14\x03\x1f\x12\xcf\x07\x04\x6f\x10\x5d\xf0\x4c\xf0\x60\x45\xf0\xd0\x5c\xf0\x5c\xf0\x50\x45\xf0\xd0" +
+	"\x5c\xf0\x5c\xf0\x50\x45\xf0\xd0\x5c\xf0\x5c\xf0\x50\x45\xf0\xd0\x5c\xf0\x5c\xf0\x50\x45\xf0\xd0\x5c\xf0\x5c\xf0\x50\x45\xf0\xd0" +
+	"\x5c\xf0\x5c\xf0\x50\x45\xf0\xd0\x5c\xf0\x5c\xf0\x50\x45\xf0\xd0\x5c\xf0\x50\x22\x10\x01\x56\x41\x02\xcf\x05\x5e\xf3\x60\x1c\xf0" +
+	"\xaf\x0c\x88\xcf\x14\x00\xcf\x18\x03\xaf\x0c\x00\xcf\x0c\x04\x3f\x10\x0c\xf0\x80\x41\xf1\x2c\xf0\x60\x5f\x13\xcf\x05\x05\xf1\x3c" +
+	"\xf0\x50\x5f\x13\xcf\x05\x05\xf1\x3c\xf0\x50\x5f\x13\xcf\x05\x05\xf1\x3c\xf0\x50\x5f\x13\xcf\x05\x05\xf1\x30\x03\x24\x63\x10\x52" +
+	"\xbf\x48\x03\x2e\xf0\xd9\x7a\xf1\xb0\x2a\xf0\xc1\x02\x4e\xf0\x60\x03\xf1\x40\x49\xf0\xd0\x06\xf0\xd0\x52\xf1\x18\xf0\xb0\x6f\x14" +
+	"\xaf\x09\x06\xef\x05\x8f\x0b\x06\xf1\x36\xf0\xd0\x53\xf1\x13\xf1\x40\x49\xf0\xd0\x1a\xf0\xd2\x02\x5f\x15\x01\x2d\xf0\xea\x8b\xf1" +
+	"\xa0\x31\xaf\x3e\x60\x73\x42\x04\x22\x10\x01\x56\x30\x3c\xf0\x57\xf3\xe5\x01\xcf\x0b\xf0\xc7\x7b\xf1\x60\x0c\xf1\x90\x39\xf0\xe1" +
+	"\xcf\x0e\x10\x4e\xf0\x7c\xf0\x90\x58\xf0\xac\xf0\x70\x56\xf0\xcc\xf0\x60\x55\xf0\xdc\xf0\x70\x57\xf0\xcc\xf0\x90\x59\xf0\xac\xf0" +
+	"\xe1\x03\x1e\xf0\x6c\xf1\xa0\x3a\xf0\xd0\x0c\xf0\xaf\x0d\x99\xdf\x15\x00\xcf\x05\x5e\xf2\xd4\x01\xcf\x05\x01\x35\x20\x3c\xf0\x50" +
+	"\x8c\xf0\x50\x8c\xf0\x50\x8c\xf0\x50\x80\x03\x36\x52\x01\x22\x02\x2d\xf3\x92\xf1\x10\x03\xef\x0d\x86\xaf\x0b\xf1\x10\x0b\xf0\xb1" +
+	"\x02\x5f\x21\x3f\x13\x04\xbf\x11\x6f\x0c\x05\x5f\x11\x8f\x0a\x05\x3f\x11\xaf\x09\x05\x2f\x11\x8f\x0a\x05\x3f\x11\x6f\x0c\x05\x5f" +
+	"\x11\x2f\x13\x04\xbf\x11\x00\xaf\x0c\x10\x27\xf2\x10\x02\xef\x0e\x98\xcf\x0a\xf1\x10\x12\xcf\x38\x2f\x11\x03\x14\x41\x00\x1f\x11" +
+	"\x08\x1f\x11\x08\x1f\x11\x08\x1f\x11\x08\x1f\x11\x22\x10\x01\x46\x5c\xf0\x56\xef\x1d\xcf\x0a\xf0\xd9\x89\xcf\x19\x03\xcf\x0d\x04" +
+	"\xcf\x08\x04\xcf\x06\x04\xcf\x05\x04\xcf\x05\x04\xcf\x05\x04\xcf\x05\x04\xcf\x05\x04\xcf\x05\x04\xcf\x05\x04\x02\x35\x65\x41\x02" +
+	"\x3c\xf5\x80\x02\xef\x0c\x76\x79\xd9\x00\x6f\x0b\x05\x20\x08\xf0\x80\x76\xf0\xe4\x06\x1d\xf1\xea\x61\x03\x18\xdf\x3a\x10\x43\x7b" +
+	"\xf1\xc0\x74\xf1\x30\x7e\xf0\x46\x30\x44\xf1\x1b\xf0\xda\x88\xaf\x18\x00\x6c\xf4\xd7\x03\x13\x54\x20\x30\x01\xad\x50\x5c\xf0\x60" +
+	"\x5c\xf0\x60\x31\x2c\xf0\x72\x22\x25\xf6\xd3\x8d\xf0\xb8\x88\x70\x1c\xf0\x60\x5c\xf0\x60\x5c\xf0\x60\x5c\xf0\x60\x5c\xf0\x60\x5c" +
+	"\xf0\x60\x5b\xf0\x60\x5a\xf0\x70\x58\xf0\xb0\x52\xf1\xed\xca\x02\x4b\xdf\x1d\x22\x06\x22\xef\x03\x04\x2f\x1e\xf0\x30\x42\xf1\xef" +
+	"\x03\x04\x2f\x1e\xf0\x30\x42\xf1\xef\x03\x04\x2f\x1e\xf0\x30\x42\xf1\xef\x03\x04\x2f\x1e\xf0\x30\x42\xf1\xdf\x04\x04\x4f\x1b\xf0" +
+	"\x70\x4a\xf1\x8f\x0d\x20\x26\xf2\x1e\xf0\xea\x9c\xf0\x9f\x10\x03\xdf\x2e\x62\xf1\x02\x24\x41\x03\x00\x22\x07\x22\x11\xf1\x30\x51" +
+	"\xf1\x40\x0a\xf0\x90\x56\xf0\xd0\x14\xf0\xe1\x04\xcf\x08\x02\xef\x05\x03\x2f\x12\x02\x8f\x0b\x03\x8f\x0b\x03\x3f\x12\x02\xdf\x06" +
+	"\x04\xcf\x07\x01\x4f\x0e\x10\x46\xf0\xd0\x19\xf0\x90\x51\xf1\x31\xef\x04\x06\xaf\x09\x5f\x0d\x07\x4f\x0e\xbf\x08\x08\xef\x22\x08" +
+	"\x8f\x1b\x04\x22\x04\x12\x20\x41\x21\xcf\x05\x03\x9f\x14\x03\xaf\x07\x8f\x09\x03\xdf\x18\x03\xef\x03\x5f\x0d\x02\x2f\x0d\xf0\xc0" +
+	"\x23\xf0\xe0\x01\xf1\x20\x16\xf0\x7d\xf0\x10\x17\xf0\xa0\x1c\xf0\x60\x1a\xf0\x39\xf0\x50\x1b\xf0\x60\x18\xf0\xa0\x1e\xe0\x05\xf0" +
+	"\x80\x1f\x12\x01\x4f\x0e\x00\x3f\x0b\x00\x1f\x0c\x00\x4f\x0d\x03\xf1\x27\xf0\x70\x1c\xf0\x18\xf0\x90\x3b\xf0\x6b\xf0\x30\x18\xf0" +
+	"\x5c\xf0\x60\x37\xf0\xbe\xe0\x24\xf0\xaf\x12\x03\x3f\x2a\x02\x1f\x2d\x05\xef\x16\x03\xbf\x19\x05\xbf\x12\x03\x8f\x15\x02\x00\x22" +
+	"\x10\x51\x22\x01\x6f\x0e\x30\x4c\xf0\xb0\x2a\xf0\xd1\x02\x9f\x0d\x10\x21\xdf\x09\x01\x5f\x14\x04\x3f\x16\x2e\xf0\x70\x66\xf0\xec" +
+	"\xf0\xb0\x8a\xf1\xe1\x08\x7f\x1a\x08\x4f\x35\x06\x1d\xf0\x97\xf0\xe2\x05\xbf\x0c\x01\xbf\x0c\x04\x7f\x0e\x20\x11\xdf\x08\x02\x3f" +
+	"\x15\x03\x4f\x15\x00\x1d\xf0\x90\x58\xf0\xe2\x00\x22\x07\x22\x11\xef\x04\x05\x1f\x13\x00\x9f\x0a\x05\x7f\x0d\x01\x3f\x11\x04\xdf" +
+	"\x07\x02\xcf\x07\x03\x4f\x11\x02\x6f\x0d\x03\xaf\x0a\x03\x1e\xf0\x40\x11\xf1\x30\x48\xf0\xa0\x17\xf0\xc0\x52\xf1\x10\x0d\xf0\x60" +
+	"\x6b\xf0\x73\xf0\xe1\x06\x5f\x0d\x9f\x09\x08\xef\x23\x08\x8f\x1c\x09\x2f\x16\x09\x4f\x0e\x10\x9a\xf0\x90\x94\xf1\x20\x61\xcd\xf1" +
+	"\x80\x71\xf1\xd8\x07\x12\x22\x22\x22\x22\x1a\xf8\x86\x99\x99\x99\x9f\x18\x06\xaf\x0d\x20\x57\xf0\xe3\x05\x5f\x14\x05\x4f\x16\x05" +
+	"\x2e\xf0\x90\x51\xdf\x0b\x06\xbf\x0c\x10\x5a\xf0\xd2\x05\x7f\x0e\x30\x6f\x1d\xbb\xbb\xbb\xb6\xf9\x80\x05\x12\x31\x03\x3b\xf2\x40" +
+	"\x3d\xf0\xd8\x72\x02\x3f\x11\x05\x4f\x0d\x06\x5f\x0c\x06\x5f\x0c\x06\x5f\x0c\x06\x6f\x0c\x06\x9f\x0a\x04\x26\xf1\x50\x3f\x2d\x50" +
+	"\x4a\xbe\xf0\xb1\x05\x1d\xf0\x70\x68\xf0\xb0\x66\xf0\xc0\x65\xf0\xc0\x65\xf0\xc0\x65\xf0\xc0\x64\xf0\xe0\x62\xf1\x40\x6a\xf1\xdc" +
+	"\x30\x31\x7b\xce\x40\x55\x00\xef\x01\xef\x01\xef\x01\xef\x01\xef\x01\xef\x01\xef\x01\xef\x01\xef\x01\xef\x01\xef\x01\xef\x01\xef" +
+	"\x01\xef\x01\xef\x01\xef\x01\xef\x01\xef\x01\xef\x01\xef\x01\xef\x01\xef\x01\xef\x01\x9a\x00\x32\x10\x6f\x2d\x50\x47\x8b\xf1\x20" +
+	"\x6c\xf0\x60\x69\xf0\x80\x68\xf0\x90\x68\xf0\x90\x68\xf0\x90\x68\xf0\xa0\x66\xf0\xd0\x62\xef\x09\x20\x53\xcf\x24\x03\x8f\x1c\xa3" +
+	"\x02\x3f\x14\x05\x7f\x0b\x06\x8f\x0a\x06\x8f\x09\x06\x8f\x09\x06\x8f\x09\x06\xaf\x08\x05\x1e\xf0\x60\x3c\xcf\x1d\x10\x3e\xdb\x82" +
+	"\x04\x03\x34\x10\x73\x00\x2b\xf3\xb6\x10\x24\xd8\x5f\x1e\xbb\xef\x2c\xbe\xf1\x67\xd4\x02\x15\xae\xf1\xea\x20\x03\x10\x72\x20\x30" +
+	"\x01\x7b\xb6\x02\xbf\x0c\xcf\x0b\x00\x6f\x06\x01\x6f\x06\xad\x03\xda\x9d\x03\xd9\x5f\x08\x01\x8f\x05\x00\xaf\x0e\xef\x09\x02\x48" +
+	"\x84\x01"
@@ -0,0 +1,121 @@
+package font
+
+import "image"
+
+// Target receives rendered glyph pixels. Ink is the 4-bit gray level to
+// paint with and alpha the glyph coverage (0 transparent, 15 opaque); the
+// target decides how to combine them with what it already holds.
+// Coordinates outside the target must be ignored.
+type Target interface {
+	BlendLevel(x, y int, ink, alpha uint8)
+}
+
+// Draw renders a single line of s with the pen starting at x and the
+// baseline at y. Newlines are ignored. It returns the pen position after the
+// last glyph.
+func Draw(t Target, f *Font, x, y int, s string, ink uint8) int {
+	prev := rune(-1)
+	for _, r := range s {
+		if r == '\n' {
+			continue
+		}
+		g := f.Glyph(r)
+		if g == nil {
+			continue
+		}
+		if prev >= 0 {
+			x += f.Kern(prev, r)
+		}
+		f.drawGlyph(t, g, x, y, ink)
+		x += int(g.Advance)
+		prev = r
+	}
+	return x
+}
+
+// DrawBox renders s inside r, wrapping lines at the box width and aligning
+// each line horizontally. Pixels falling outside r are clipped.
+func DrawBox(t Target, f *Font, r image.Rectangle, s string, align Align, ink uint8) {
+	DrawBoxClip(t, f, r, r, s, align, ink)
+}
+
+// DrawBoxClip is DrawBox with pixels also clipped to clip. Lines lying
+// wholly outside clip are laid out but not rendered, so a tall box can be
+// drawn in bands of rows at little extra cost.
+func DrawBoxClip(t Target, f *Font, r, clip image.Rectangle, s string, align Align, ink uint8) {
+	clip = clip.Intersect(r)
+	ct := clipTarget{t: t, r: clip}
+	y := r.Min.Y + f.Ascent
+	for rest := s; y-f.Ascent < r.Max.Y; y += f.LineHeight {
+		var line string
+		line, rest = f.nextLine(rest, r.Dx())
+		if top, bottom := f.rows(line); y-top < clip.Max.Y && y+bottom > clip.Min.Y {
+			x := r.Min.X
+			switch align {
+			case AlignCenter:
+				x += (r.Dx() - f.Width(line)) / 2
+			case AlignRight:
+				x += r.Dx() - f.Width(line)
+			}
+			Draw(ct, f, x, y, line, ink)
+		}
+		if rest == "" {
+			break
+		}
+	}
+}
+
+// rows returns how far the glyphs of s reach above and below the baseline.
+func (f *Font) rows(s string) (top, bottom int) {
+	for _, r := range s {
+		if g := f.Glyph(r); g != nil {
+			top = max(top, int(g.Top))
+			bottom = max(bottom, int(g.Height)-int(g.Top))
+		}
+	}
+	return top, bottom
+}
+
+// drawGlyph blits the coverage map of g with its origin at the pen position.
+func (f *Font) drawGlyph(t Target, g *Glyph, x, y int, ink uint8) {
+	x0 := x + int(g.Left)
+	y0 := y - int(g.Top)
+	if f.Compressed {
+		rd := newRLEReader(f.Bitmap, g.Offset)
+		for row := 0; row < int(g.Height); row++ {
+			for col := 0; col < int(g.Width); col++ {
+				if a := rd.next(); a != 0 {
+					t.BlendLevel(x0+col, y0+row, ink, a)
+				}
+			}
+		}
+		return
+	}
+	stride := (int(g.Width) + 1) / 2
+	for row := 0; row < int(g.Height); row++ {
+		off := int(g.Offset) + row*stride
+		for col := 0; col < int(g.Width); col++ {
+			b := f.Bitmap[off+col>>1]
+			a := b >> 4
+			if col&1 == 1 {
+				a = b & 0x0F
+			}
+			if a != 0 {
+				t.BlendLevel(x0+col, y0+row, ink, a)
+			}
+		}
+	}
+}
+
+// clipTarget drops pixels outside r before forwarding them.
+type clipTarget struct {
+	t Target
+	r image.Rectangle
+}
+
+func (c clipTarget) BlendLevel(x, y int, ink, alpha uint8) {
+	if x < c.r.Min.X || y < c.r.Min.Y || x >= c.r.Max.X || y >= c.r.Max.Y {
+		return
+	}
+	c.t.BlendLevel(x, y, ink, alpha)
+}
@@ -0,0 +1,200 @@
+// Package font provides embedded bitmap fonts and a small text renderer for
+// the epd47 driver.
+//
+// Glyphs are stored as anti-aliased 4bpp coverage maps (0 transparent,
+// 15 opaque), packed two pixels per byte with the high nibble first and each
+// glyph row padded to a whole byte, the same layout DrawImage4bpp consumes.
+// Fonts produced by cmd/fontconv are usually run-length compressed instead;
+// see AppendRLE for the encoding.
+// Fonts carry variable advances and an optional kerning table, and rendering
+// goes through the Target interface so the same glyphs can land in a 1bpp or
+// 4bpp buffer.
+package font
+
+// Glyph describes a single character bitmap inside Font.Bitmap.
+type Glyph struct {
+	Rune    rune
+	Offset  uint32 // start of the packed bitmap in Font.Bitmap
+	Width   uint8  // bitmap width in pixels
+	Height  uint8  // bitmap height in pixels
+	Advance uint8  // pen advance in pixels
+	Left    int8   // offset from the pen position to the bitmap's left edge
+	Top     int8   // distance from the baseline up to the bitmap's top edge
+}
+
+// Kern adjusts the advance between a pair of code points.
+type Kern struct {
+	Left, Right rune
+	Adjust      int8
+}
+
+// Font is an embedded bitmap font.
+//
+// Bitmap is a string rather than a []byte so that TinyGo keeps the glyph data
+// in flash instead of copying it to RAM at startup.
+type Font struct {
+	Name       string
+	Ascent     int // pixels above the baseline
+	Descent    int // pixels below the baseline
+	LineHeight int // baseline-to-baseline distance
+
+	Glyphs  []Glyph // sorted by Rune
+	Kerning []Kern  // sorted by Left, then Right
+	Bitmap  string
+
+	// Compressed marks Bitmap as run-length encoded glyph streams
+	// (see AppendRLE) rather than packed rows.
+	Compressed bool
+
+	// Fallback is drawn for code points missing from Glyphs.
+	// Zero skips missing code points entirely.
+	Fallback rune
+}
+
+// Align selects the horizontal placement of lines inside a box.
+type Align uint8
+
+const (
+	AlignLeft Align = iota
+	AlignCenter
+	AlignRight
+)
+
+// Glyph returns the glyph for r, the fallback glyph if r is missing, or nil.
+func (f *Font) Glyph(r rune) *Glyph {
+	if g := f.lookup(r); g != nil {
+		return g
+	}
+	if f.Fallback != 0 {
+		return f.lookup(f.Fallback)
+	}
+	return nil
+}
+
+// lookup binary-searches Glyphs for r.
+func (f *Font) lookup(r rune) *Glyph {
+	lo, hi := 0, len(f.Glyphs)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if f.Glyphs[m].Rune < r {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	if lo < len(f.Glyphs) && f.Glyphs[lo].Rune == r {
+		return &f.Glyphs[lo]
+	}
+	return nil
+}
+
+// Kern returns the advance adjustment between left and right in pixels.
+func (f *Font) Kern(left, right rune) int {
+	lo, hi := 0, len(f.Kerning)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		k := f.Kerning[m]
+		if k.Left < left || (k.Left == left && k.Right < right) {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	if lo < len(f.Kerning) && f.Kerning[lo].Left == left && f.Kerning[lo].Right == right {
+		return int(f.Kerning[lo].Adjust)
+	}
+	return 0
+}
+
+// Width returns the advance width of s as a single line, kerning included.
+// Newlines are ignored.
+func (f *Font) Width(s string) int {
+	w := 0
+	prev := rune(-1)
+	for _, r := range s {
+		if r == '\n' {
+			continue
+		}
+		g := f.Glyph(r)
+		if g == nil {
+			continue
+		}
+		if prev >= 0 {
+			w += f.Kern(prev, r)
+		}
+		w += int(g.Advance)
+		prev = r
+	}
+	return w
+}
+
+// Measure returns the size of the box needed to draw s with lines wrapped
+// at maxWidth. A maxWidth of zero or less only breaks at newlines.
+func (f *Font) Measure(s string, maxWidth int) (w, h int) {
+	lines := 0
+	for rest := s; ; {
+		var line string
+		line, rest = f.nextLine(rest, maxWidth)
+		if lw := f.Width(line); lw > w {
+			w = lw
+		}
+		lines++
+		if rest == "" {
+			break
+		}
+	}
+	if s == "" {
+		return 0, 0
+	}
+	return w, f.Ascent + f.Descent + (lines-1)*f.LineHeight
+}
+
+// nextLine splits the first line off s. Lines end at a newline or at the
+// last space that keeps the line within maxWidth; a word wider than maxWidth
+// is broken between characters. The separator is not part of either result.
+func (f *Font) nextLine(s string, maxWidth int) (line, rest string) {
+	w := 0
+	prev := rune(-1)
+	space := -1
+	for i, r := range s {
+		if r == '\n' {
+			return s[:i], s[i+1:]
+		}
+		g := f.Glyph(r)
+		if g == nil {
+			continue
+		}
+		adv := int(g.Advance)
+		if prev >= 0 {
+			adv += f.Kern(prev, r)
+		}
+		if maxWidth > 0 && w+adv > maxWidth && r != ' ' {
+			if space >= 0 {
+				return trimSpaces(s[:space], false), trimSpaces(s[space+1:], true)
+			}
+			if i > 0 {
+				return s[:i], s[i:]
+			}
+		}
+		if r == ' ' {
+			space = i
+		}
+		w += adv
+		prev = r
+	}
+	return s, ""
+}
+
+// trimSpaces strips ASCII spaces from the start (leading) or end of s.
+func trimSpaces(s string, leading bool) string {
+	if leading {
+		for len(s) > 0 && s[0] == ' ' {
+			s = s[1:]
+		}
+		return s
+	}
+	for len(s) > 0 && s[len(s)-1] == ' ' {
+		s = s[:len(s)-1]
+	}
+	return s
+}
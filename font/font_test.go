@@ -0,0 +1,246 @@
+package font
+
+import (
+	"image"
+	"testing"
+)
+
+// testFont has two solid glyphs: 'A' (3x2, advance 4) and 'B' (2x2, advance 3),
+// plus a 2px wide space and a kerning pair pulling 'B' towards 'A'.
+var testFont = &Font{
+	Name:       "test",
+	Ascent:     2,
+	Descent:    1,
+	LineHeight: 4,
+	Glyphs: []Glyph{
+		{' ', 0, 0, 0, 2, 0, 0},
+		{'A', 0, 3, 2, 4, 0, 2},
+		{'B', 4, 2, 2, 3, 0, 2},
+	},
+	Kerning:  []Kern{{'A', 'B', -1}},
+	Bitmap:   "\xFF\xF0\xFF\xF0\xFF\xFF",
+	Fallback: 'B',
+}
+
+// gridTarget records the last ink written per pixel.
+type gridTarget struct {
+	w, h int
+	pix  []uint8
+}
+
+func newGridTarget(w, h int) *gridTarget {
+	return &gridTarget{w: w, h: h, pix: make([]uint8, w*h)}
+}
+
+func (g *gridTarget) BlendLevel(x, y int, ink, alpha uint8) {
+	if x < 0 || y < 0 || x >= g.w || y >= g.h {
+		return
+	}
+	g.pix[y*g.w+x] = ink
+}
+
+func (g *gridTarget) count() int {
+	n := 0
+	for _, v := range g.pix {
+		if v != 0 {
+			n++
+		}
+	}
+	return n
+}
+
+func TestGlyphLookup(t *testing.T) {
+	if g := testFont.Glyph('A'); g == nil || g.Width != 3 {
+		t.Fatalf("Expected glyph 'A' of width 3, got %+v", g)
+	}
+	if g := testFont.Glyph('Z'); g == nil || g.Rune != 'B' {
+		t.Errorf("Expected fallback glyph 'B' for missing rune, got %+v", g)
+	}
+
+	noFallback := *testFont
+	noFallback.Fallback = 0
+	if g := noFallback.Glyph('Z'); g != nil {
+		t.Errorf("Expected nil for missing rune without fallback, got %+v", g)
+	}
+}
+
+func TestKerningAndWidth(t *testing.T) {
+	if k := testFont.Kern('A', 'B'); k != -1 {
+		t.Errorf("Expected kerning -1 for AB, got %d", k)
+	}
+	if k := testFont.Kern('B', 'A'); k != 0 {
+		t.Errorf("Expected no kerning for BA, got %d", k)
+	}
+	if w := testFont.Width("AB"); w != 6 {
+		t.Errorf("Expected width 6 for AB, got %d", w)
+	}
+	if w := testFont.Width("A B"); w != 9 {
+		t.Errorf("Expected width 9 for 'A B', got %d", w)
+	}
+}
+
+func TestUTF8Decoding(t *testing.T) {
+	// A multi-byte rune missing from the font draws the fallback once.
+	if w := testFont.Width("A€"); w != 7 {
+		t.Errorf("Expected width 7 for 'A€', got %d", w)
+	}
+}
+
+func TestMeasureWrapping(t *testing.T) {
+	w, h := testFont.Measure("AA AA", 0)
+	if w != 18 || h != 3 {
+		t.Errorf("Expected 18x3 unwrapped, got %dx%d", w, h)
+	}
+
+	// Wraps at the space, dropping it.
+	w, h = testFont.Measure("AA AA", 10)
+	if w != 8 || h != 7 {
+		t.Errorf("Expected 8x7 wrapped at space, got %dx%d", w, h)
+	}
+
+	// A word wider than the box breaks between characters.
+	w, h = testFont.Measure("AAA", 8)
+	if w != 8 || h != 7 {
+		t.Errorf("Expected 8x7 for broken word, got %dx%d", w, h)
+	}
+
+	// Explicit newline.
+	_, h = testFont.Measure("A\nA", 0)
+	if h != 7 {
+		t.Errorf("Expected height 7 for two lines, got %d", h)
+	}
+
+	if w, h := testFont.Measure("", 10); w != 0 || h != 0 {
+		t.Errorf("Expected 0x0 for empty string, got %dx%d", w, h)
+	}
+}
+
+func TestDraw(t *testing.T) {
+	g := newGridTarget(16, 4)
+	end := Draw(g, testFont, 0, 2, "AB", 15)
+	if end != 6 {
+		t.Errorf("Expected pen at 6, got %d", end)
+	}
+	// 'A' covers 3x2 at x=0, 'B' 2x2 at x=3 (kerned): 10 pixels.
+	if n := g.count(); n != 10 {
+		t.Errorf("Expected 10 inked pixels, got %d", n)
+	}
+	if g.pix[0*16+3] != 15 || g.pix[1*16+4] != 15 {
+		t.Error("Expected kerned 'B' at x=3..4")
+	}
+	if g.pix[0*16+5] != 0 {
+		t.Error("Expected no ink right of 'B'")
+	}
+}
+
+func TestDrawBoxAlignAndClip(t *testing.T) {
+	g := newGridTarget(16, 8)
+	DrawBox(g, testFont, image.Rect(0, 0, 10, 8), "B", AlignRight, 15)
+	if g.pix[0*16+7] != 15 || g.pix[0*16+8] != 15 || g.pix[0*16+6] != 0 {
+		t.Error("Expected right-aligned 'B' at x=7..8")
+	}
+
+	g = newGridTarget(16, 8)
+	DrawBox(g, testFont, image.Rect(0, 0, 10, 8), "B", AlignCenter, 15)
+	if g.pix[0*16+3] != 15 || g.pix[0*16+4] != 15 {
+		t.Error("Expected centered 'B' at x=3..4")
+	}
+
+	// Second line starts at y=4 and is clipped by a 5px tall box.
+	g = newGridTarget(16, 8)
+	DrawBox(g, testFont, image.Rect(0, 0, 10, 5), "AA AA", AlignLeft, 15)
+	if g.pix[4*16] != 15 {
+		t.Error("Expected first row of second line inside box")
+	}
+	if g.pix[5*16] != 0 {
+		t.Error("Expected second row of second line clipped")
+	}
+
+	// A band clip draws only its rows of the box.
+	g = newGridTarget(16, 8)
+	DrawBoxClip(g, testFont, image.Rect(0, 0, 10, 8), image.Rect(0, 4, 16, 5), "AA AA", AlignLeft, 15)
+	if g.pix[4*16] != 15 || g.pix[0*16] != 0 || g.pix[5*16] != 0 {
+		t.Error("Expected only row 4 of the box drawn")
+	}
+}
+
+func TestBuiltinFonts(t *testing.T) {
+	for _, f := range []*Font{DejaVuSans16, DejaVuSans24} {
+		if !f.Compressed {
+			t.Errorf("%s: expected compressed glyphs", f.Name)
+		}
+		for i, g := range f.Glyphs {
+			if i > 0 && f.Glyphs[i-1].Rune >= g.Rune {
+				t.Errorf("%s: glyphs not sorted at %q", f.Name, g.Rune)
+			}
+			// Decoding past the end of Bitmap would panic.
+			rd := newRLEReader(f.Bitmap, g.Offset)
+			for n := int(g.Width) * int(g.Height); n > 0; n-- {
+				rd.next()
+			}
+		}
+		for i := 1; i < len(f.Kerning); i++ {
+			a, b := f.Kerning[i-1], f.Kerning[i]
+			if a.Left > b.Left || (a.Left == b.Left && a.Right >= b.Right) {
+				t.Errorf("%s: kerning not sorted at %q%q", f.Name, b.Left, b.Right)
+			}
+		}
+		for r := rune(' '); r <= '~'; r++ {
+			if f.lookup(r) == nil {
+				t.Errorf("%s: missing printable ASCII %q", f.Name, r)
+			}
+		}
+	}
+}
+
+func TestRLERoundTrip(t *testing.T) {
+	levels := []uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, // run longer than 16
+		3, 15, 15, 15, 7, 0, 14, 1, 15, 0,
+	}
+	enc := AppendRLE(nil, levels)
+	if len(enc) >= (len(levels)+1)/2 {
+		t.Errorf("Expected compression below %d bytes, got %d", (len(levels)+1)/2, len(enc))
+	}
+
+	// Decode twice from a shared string to check byte-aligned offsets.
+	src := string(append(append([]byte{}, enc...), enc...))
+	for _, off := range []uint32{0, uint32(len(enc))} {
+		rd := newRLEReader(src, off)
+		for i, want := range levels {
+			if got := rd.next(); got != want {
+				t.Fatalf("Offset %d pixel %d: expected %d, got %d", off, i, want, got)
+			}
+		}
+	}
+
+	// A compressed font draws the same pixels as the packed one.
+	packed := newGridTarget(16, 4)
+	Draw(packed, testFont, 0, 2, "AB", 15)
+
+	c := *testFont
+	c.Compressed = true
+	var bm []byte
+	c.Glyphs = append([]Glyph(nil), testFont.Glyphs...)
+	for i := range c.Glyphs {
+		g := &c.Glyphs[i]
+		g.Offset = uint32(len(bm))
+		bm = AppendRLE(bm, make15(int(g.Width)*int(g.Height)))
+	}
+	c.Bitmap = string(bm)
+	compressed := newGridTarget(16, 4)
+	Draw(compressed, &c, 0, 2, "AB", 15)
+	for i := range packed.pix {
+		if packed.pix[i] != compressed.pix[i] {
+			t.Fatalf("Pixel %d differs between packed and compressed fonts", i)
+		}
+	}
+}
+
+func make15(n int) []uint8 {
+	v := make([]uint8, n)
+	for i := range v {
+		v[i] = 15
+	}
+	return v
+}
@@ -0,0 +1,14 @@
+package font
+
+// The built-in fonts are DejaVu Sans, printable ASCII plus the degree sign.
+// Regenerate them with DEJAVU_DIR pointing at the directory holding
+// DejaVuSans.ttf (for example /usr/share/fonts/truetype/dejavu):
+//
+//	DEJAVU_DIR=/usr/share/fonts/truetype/dejavu go generate ./font
+//
+// DejaVu fonts are Copyright (c) 2003 by Bitstream, Inc. with changes placed
+// in the public domain by the DejaVu project; Bitstream Vera is a trademark of
+// Bitstream, Inc. See https://dejavu-fonts.github.io/License.html.
+
+//go:generate go run ../cmd/fontconv -in $DEJAVU_DIR/DejaVuSans.ttf -size 16 -ranges 32-126,0xB0 -name DejaVuSans16 -out dejavu_sans16.go
+//go:generate go run ../cmd/fontconv -in $DEJAVU_DIR/DejaVuSans.ttf -size 24 -ranges 32-126,0xB0 -name DejaVuSans24 -out dejavu_sans24.go
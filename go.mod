@@ -1,3 +1,7 @@
 module github.com/abaschen/tinygo-epd47-s3
 
-go 1.24
+go 1.24.0
+
+require golang.org/x/image v0.32.0
+
+require golang.org/x/text v0.30.0 // indirect
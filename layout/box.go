@@ -0,0 +1,102 @@
+package layout
+
+import "image"
+
+// Direction is a Box's main axis.
+type Direction uint8
+
+const (
+	Horizontal Direction = iota
+	Vertical
+)
+
+// Child is one entry of a Box. A nil Node leaves empty space.
+type Child struct {
+	Node Node
+	Size Size // along the box's main axis
+
+	// Cross is the extent across the main axis, 0 to fill the box, and
+	// Align places it when set; Stretch then behaves like Start.
+	Cross int
+	Align Align
+}
+
+// Fixed returns a child n pixels long on the main axis.
+func Fixed(px int, n Node) Child { return Child{Node: n, Size: Px(px)} }
+
+// Flex returns a child taking a weight-proportional share of the free space.
+func Flex(weight int, n Node) Child { return Child{Node: n, Size: Weight(weight)} }
+
+// Space returns empty flexible space, e.g. to push children apart.
+func Space(weight int) Child { return Flex(weight, nil) }
+
+// Box lays out children in a row or column, like a CSS flex container.
+type Box struct {
+	Dir      Direction
+	Padding  Insets
+	Gap      int // pixels between children
+	Children []Child
+
+	// Justify places the children along the main axis when they are all
+	// fixed and leave space over; Stretch behaves like Start.
+	Justify Align
+
+	bounds image.Rectangle
+}
+
+// Row returns a horizontal box.
+func Row(children ...Child) *Box { return &Box{Dir: Horizontal, Children: children} }
+
+// Column returns a vertical box.
+func Column(children ...Child) *Box { return &Box{Dir: Vertical, Children: children} }
+
+// Add appends children and returns the box.
+func (b *Box) Add(children ...Child) *Box {
+	b.Children = append(b.Children, children...)
+	return b
+}
+
+// Bounds returns the area last given to SetBounds.
+func (b *Box) Bounds() image.Rectangle { return b.bounds }
+
+// Relayout places the children again, e.g. after editing Children.
+func (b *Box) Relayout() { b.SetBounds(b.bounds) }
+
+// SetBounds implements Node and places every child.
+func (b *Box) SetBounds(r image.Rectangle) {
+	b.bounds = r
+	in := b.Padding.Apply(r)
+
+	// Work in main/cross coordinates and swap back for vertical boxes.
+	main0, main1, cross0, cross1 := in.Min.X, in.Max.X, in.Min.Y, in.Max.Y
+	if b.Dir == Vertical {
+		main0, main1, cross0, cross1 = in.Min.Y, in.Max.Y, in.Min.X, in.Max.X
+	}
+	sizes := make([]Size, len(b.Children))
+	for i, c := range b.Children {
+		sizes[i] = c.Size
+	}
+	ext := split(main1-main0, b.Gap, sizes)
+
+	used := b.Gap * max(len(ext)-1, 0)
+	for _, e := range ext {
+		used += e
+	}
+	pos, _ := place(main0, main1-main0, used, b.Justify)
+
+	for i, c := range b.Children {
+		if c.Node != nil {
+			a := c.Align
+			if a == Stretch {
+				a = Start // a set Cross is kept
+			}
+			cp, cn := place(cross0, cross1-cross0, c.Cross, a)
+			rc := image.Rect(pos, cp, pos+ext[i], cp+cn)
+			if b.Dir == Vertical {
+				rc = image.Rect(cp, pos, cp+cn, pos+ext[i])
+			}
+			c.Node.SetBounds(rc)
+		}
+		pos += ext[i] + b.Gap
+	}
+}
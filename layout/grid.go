@@ -0,0 +1,76 @@
+package layout
+
+import "image"
+
+// Cell is a node placed in a Grid, spanning one or more tracks.
+type Cell struct {
+	Node             Node
+	Col, Row         int
+	ColSpan, RowSpan int // 0 counts as 1
+}
+
+// Grid lays out cells in columns and rows whose sizes are fixed or
+// proportional, like a CSS grid.
+type Grid struct {
+	Cols, Rows []Size
+	Padding    Insets
+	Gap        int // pixels between tracks in both directions
+	Cells      []Cell
+
+	bounds image.Rectangle
+}
+
+// NewGrid returns a grid of cols x rows equal tracks.
+func NewGrid(cols, rows int) *Grid {
+	return &Grid{Cols: make([]Size, max(cols, 1)), Rows: make([]Size, max(rows, 1))}
+}
+
+// Place adds n at column col and row row, spanning colSpan x rowSpan
+// tracks, and returns the grid.
+func (g *Grid) Place(n Node, col, row, colSpan, rowSpan int) *Grid {
+	g.Cells = append(g.Cells, Cell{Node: n, Col: col, Row: row, ColSpan: colSpan, RowSpan: rowSpan})
+	return g
+}
+
+// Bounds returns the area last given to SetBounds.
+func (g *Grid) Bounds() image.Rectangle { return g.bounds }
+
+// Relayout places the cells again, e.g. after editing Cells.
+func (g *Grid) Relayout() { g.SetBounds(g.bounds) }
+
+// SetBounds implements Node and places every cell. Cells outside the
+// tracks are clamped into the grid.
+func (g *Grid) SetBounds(r image.Rectangle) {
+	g.bounds = r
+	in := g.Padding.Apply(r)
+	xs := offsets(in.Min.X, g.Gap, split(in.Dx(), g.Gap, g.Cols))
+	ys := offsets(in.Min.Y, g.Gap, split(in.Dy(), g.Gap, g.Rows))
+	for _, c := range g.Cells {
+		if c.Node == nil || len(xs) == 0 || len(ys) == 0 {
+			continue
+		}
+		x0, x1 := span(xs, c.Col, c.ColSpan)
+		y0, y1 := span(ys, c.Row, c.RowSpan)
+		c.Node.SetBounds(image.Rect(x0, y0, x1, y1))
+	}
+}
+
+// track is the start and end of one column or row.
+type track struct{ start, end int }
+
+// offsets turns extents into track positions from pos.
+func offsets(pos, gap int, ext []int) []track {
+	ts := make([]track, len(ext))
+	for i, e := range ext {
+		ts[i] = track{pos, pos + e}
+		pos += e + gap
+	}
+	return ts
+}
+
+// span returns the extent of n tracks from i, gaps included.
+func span(ts []track, i, n int) (int, int) {
+	i = max(0, min(i, len(ts)-1))
+	j := max(i, min(i+max(n, 1), len(ts))-1)
+	return ts[i].start, ts[j].end
+}
@@ -0,0 +1,170 @@
+// Package layout arranges widgets in flex-like rows and columns and in
+// grids, so screens are described by structure instead of hand-computed
+// pixel offsets.
+//
+// Everything placeable is a Node: widgets from package widget already are,
+// through their SetBounds method, and so are the containers here, which
+// nest freely. Setting a container's bounds places its children, so
+// attaching the root to a widget.Screen with SetLayout lays out the whole
+// tree and repeats it when the screen is rotated:
+//
+//	root := layout.Column(
+//		layout.Fixed(60, title),
+//		layout.Flex(1, layout.Row(
+//			layout.Flex(2, chart),
+//			layout.Flex(1, layout.Column(
+//				layout.Flex(1, temp),
+//				layout.Flex(1, humidity),
+//			)),
+//		)),
+//	)
+//	root.Padding = layout.Pad(20)
+//	root.Gap = 10
+//	screen.SetLayout(root)
+//
+// Sizes along a container's main axis are fixed pixel counts or weights
+// sharing the space left over; leftover pixels from rounding go to the
+// first flexible children so the children always fill the container.
+package layout
+
+import "image"
+
+// Node is anything a layout can place.
+type Node interface {
+	SetBounds(r image.Rectangle)
+}
+
+// Size is an extent along a container's main axis: a share of the free
+// space proportional to a positive Weight, otherwise Px pixels. A negative
+// Weight marks the size fixed, so Px(0) stays 0 pixels wide, while the
+// zero Size is a weight of 1.
+type Size struct {
+	Px     int
+	Weight int
+}
+
+// Px returns a fixed size of n pixels; Px(0) collapses its child.
+func Px(n int) Size { return Size{Px: max(n, 0), Weight: -1} }
+
+// Weight returns a proportional size.
+func Weight(w int) Size { return Size{Weight: max(w, 1)} }
+
+func (s Size) weight() int {
+	if s.Weight > 0 {
+		return s.Weight
+	}
+	if s.Weight == 0 && s.Px == 0 {
+		return 1
+	}
+	return 0
+}
+
+// Insets is padding inside a rectangle's edges.
+type Insets struct {
+	Top, Right, Bottom, Left int
+}
+
+// Pad returns equal insets on all sides.
+func Pad(n int) Insets { return Insets{n, n, n, n} }
+
+// Apply shrinks r by the insets; a rectangle too small becomes empty at
+// its center.
+func (in Insets) Apply(r image.Rectangle) image.Rectangle {
+	r = image.Rectangle{
+		Min: image.Pt(r.Min.X+in.Left, r.Min.Y+in.Top),
+		Max: image.Pt(r.Max.X-in.Right, r.Max.Y-in.Bottom),
+	}
+	if r.Min.X > r.Max.X {
+		r.Min.X = (r.Min.X + r.Max.X) / 2
+		r.Max.X = r.Min.X
+	}
+	if r.Min.Y > r.Max.Y {
+		r.Min.Y = (r.Min.Y + r.Max.Y) / 2
+		r.Max.Y = r.Min.Y
+	}
+	return r
+}
+
+// Align places a child smaller than its slot.
+type Align uint8
+
+const (
+	Stretch Align = iota // fill the slot
+	Start                // left or top
+	Center
+	End // right or bottom
+)
+
+// place positions an extent of size n in a slot of length total starting
+// at pos; Stretch or a size of 0 fills the slot.
+func place(pos, total, n int, a Align) (int, int) {
+	if a == Stretch || n <= 0 || n >= total {
+		return pos, total
+	}
+	switch a {
+	case Center:
+		pos += (total - n) / 2
+	case End:
+		pos += total - n
+	}
+	return pos, n
+}
+
+// split divides total pixels among sizes with gap pixels between them and
+// returns each extent. Fixed sizes are served first; if they overflow the
+// flexible sizes get nothing.
+func split(total, gap int, sizes []Size) []int {
+	out := make([]int, len(sizes))
+	free := total - gap*max(len(sizes)-1, 0)
+	weights := 0
+	for i, s := range sizes {
+		if w := s.weight(); w > 0 {
+			weights += w
+		} else {
+			out[i] = s.Px
+			free -= s.Px
+		}
+	}
+	if weights == 0 || free <= 0 {
+		return out
+	}
+	rem := free
+	for i, s := range sizes {
+		if w := s.weight(); w > 0 {
+			out[i] = free * w / weights
+			rem -= out[i]
+		}
+	}
+	for i, s := range sizes {
+		if rem == 0 {
+			break
+		}
+		if s.weight() > 0 {
+			out[i]++
+			rem--
+		}
+	}
+	return out
+}
+
+// Padded wraps a node with insets.
+type Padded struct {
+	Node   Node
+	Insets Insets
+
+	bounds image.Rectangle
+}
+
+// Inset returns n padded by in.
+func Inset(n Node, in Insets) *Padded { return &Padded{Node: n, Insets: in} }
+
+// Bounds returns the area last given to SetBounds, padding included.
+func (p *Padded) Bounds() image.Rectangle { return p.bounds }
+
+// SetBounds implements Node.
+func (p *Padded) SetBounds(r image.Rectangle) {
+	p.bounds = r
+	if p.Node != nil {
+		p.Node.SetBounds(p.Insets.Apply(r))
+	}
+}
@@ -0,0 +1,110 @@
+package layout
+
+import (
+	"image"
+	"testing"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+	"github.com/abaschen/tinygo-epd47-s3/widget"
+)
+
+// rect records the bounds it is given.
+type rect struct{ r image.Rectangle }
+
+func (n *rect) SetBounds(r image.Rectangle) { n.r = r }
+
+func TestSplit(t *testing.T) {
+	got := split(100, 5, []Size{Px(20), Weight(1), Weight(2), {}})
+	// 100 - 3*5 gaps - 20 fixed = 65 shared 1:2:1 -> 16, 32, 16 plus one
+	// leftover pixel to the first flexible child.
+	want := []int{20, 17, 32, 16}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Expected %v, got %v", want, got)
+		}
+	}
+	if got := split(10, 0, []Size{Px(8), Px(8), Weight(1)}); got[2] != 0 {
+		t.Errorf("Expected overflowing fixed sizes to starve flexible ones, got %v", got)
+	}
+	if got := split(30, 0, []Size{Px(0), Weight(1), {}}); got[0] != 0 || got[1] != 15 || got[2] != 15 {
+		t.Errorf("Expected Px(0) to stay empty and the zero Size to flex, got %v", got)
+	}
+}
+
+func TestBox(t *testing.T) {
+	a, b, c := &rect{}, &rect{}, &rect{}
+	row := Row(Fixed(100, a), Space(1), Child{Node: b, Size: Weight(1), Cross: 20, Align: Center})
+	row.Padding = Insets{Top: 10, Left: 10, Right: 10, Bottom: 10}
+	row.Gap = 10
+	row.SetBounds(image.Rect(0, 0, 400, 100))
+	if a.r != image.Rect(10, 10, 110, 90) {
+		t.Errorf("Unexpected fixed child %v", a.r)
+	}
+	// 380 - 20 gaps - 100 = 260 split between the space and b.
+	if b.r != image.Rect(260, 40, 390, 60) {
+		t.Errorf("Unexpected flexible child %v", b.r)
+	}
+
+	// A Cross without an Align starts at the top instead of filling.
+	Row(Child{Node: c, Size: Px(50), Cross: 20}).SetBounds(image.Rect(0, 0, 100, 60))
+	if c.r != image.Rect(0, 0, 50, 20) {
+		t.Errorf("Expected the cross extent kept, got %v", c.r)
+	}
+
+	col := Column(Fixed(30, a), Fixed(30, c))
+	col.Justify = End
+	col.SetBounds(image.Rect(0, 0, 50, 100))
+	if a.r != image.Rect(0, 40, 50, 70) || c.r != image.Rect(0, 70, 50, 100) {
+		t.Errorf("Expected children packed at the bottom, got %v %v", a.r, c.r)
+	}
+
+	Inset(c, Pad(5)).SetBounds(image.Rect(0, 0, 8, 20))
+	if c.r != image.Rect(4, 5, 4, 15) {
+		t.Errorf("Expected an over-padded node to collapse at the center, got %v", c.r)
+	}
+}
+
+func TestGrid(t *testing.T) {
+	a, b := &rect{}, &rect{}
+	g := NewGrid(3, 2)
+	g.Cols[0] = Px(40)
+	g.Gap = 4
+	g.Place(a, 0, 0, 1, 2).Place(b, 1, 1, 5, 1)
+	g.SetBounds(image.Rect(0, 0, 128, 64))
+	if a.r != image.Rect(0, 0, 40, 64) {
+		t.Errorf("Expected a to span both rows, got %v", a.r)
+	}
+	// Columns: 40, then 80 left for two -> 40, 40; the span is clamped.
+	if b.r != image.Rect(44, 34, 128, 64) {
+		t.Errorf("Expected b clamped to the last column, got %v", b.r)
+	}
+}
+
+// fakeDisplay accepts updates without drawing.
+type fakeDisplay struct{ w, h int }
+
+func (f fakeDisplay) Bounds() image.Rectangle                 { return image.Rect(0, 0, f.w, f.h) }
+func (f fakeDisplay) ClearArea(r image.Rectangle, cycles int) {}
+func (f fakeDisplay) DrawRows4bpp(x, y, w, h int, _ epd47.RowSource, _ epd47.DrawMode) error {
+	return nil
+}
+
+func TestRelayoutOnRotation(t *testing.T) {
+	s := widget.NewScreen(fakeDisplay{960, 540})
+	title := widget.NewLabel("Title", nil)
+	body := widget.NewLabel("Body", nil)
+	s.Add(title, body)
+	s.SetLayout(Column(Fixed(60, title), Flex(1, body)))
+	if body.Bounds() != image.Rect(0, 60, 960, 540) {
+		t.Errorf("Unexpected landscape layout %v", body.Bounds())
+	}
+	s.Update()
+
+	s.SetRotation(epd47.Rotate90)
+	if s.Bounds() != image.Rect(0, 0, 540, 960) || body.Bounds() != image.Rect(0, 60, 540, 960) {
+		t.Errorf("Expected a portrait re-layout, got %v", body.Bounds())
+	}
+	if rs := s.Dirty(); len(rs) != 1 || rs[0] != image.Rect(0, 0, 960, 540) {
+		t.Errorf("Expected a full repaint after rotating, got %v", rs)
+	}
+}
@@ -0,0 +1,326 @@
+// Package netpbm reads and writes PBM (P1, P4) and PGM (P2, P5) images
+// straight into and out of the epd47 packed bitmap formats.
+//
+// PBM maps one-to-one onto Bitmap1bpp: rows are MSB first, padded to a
+// byte, and a set bit is black. PGM gray values are scaled from the file's
+// maxval and mapped to the nearest of the 16 ink levels with
+// epd47.GrayToLevel; written PGMs use maxval 255 and epd47.LevelToGray so
+// levels survive a round trip.
+package netpbm
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+)
+
+// Errors returned by the decoders.
+var (
+	ErrFormat      = errors.New("netpbm: not a PBM or PGM image")
+	ErrUnsupported = errors.New("netpbm: unsupported netpbm variant")
+	ErrHeader      = errors.New("netpbm: invalid header")
+	ErrData        = errors.New("netpbm: invalid or truncated pixel data")
+)
+
+// maxSize bounds width and height to keep a corrupt header from
+// allocating huge bitmaps.
+const maxSize = 1 << 14
+
+// Header describes a netpbm image.
+type Header struct {
+	Magic         byte // '1', '2', '4' or '5'
+	Width, Height int
+	MaxVal        int // 1 for PBM
+}
+
+// Plain reports whether the pixel data is ASCII (P1, P2).
+func (h Header) Plain() bool { return h.Magic == '1' || h.Magic == '2' }
+
+// Bitmap reports whether the image is a PBM bitmap (P1, P4).
+func (h Header) Bitmap() bool { return h.Magic == '1' || h.Magic == '4' }
+
+// decoder reads the header and pixels of one image.
+type decoder struct {
+	r *bufio.Reader
+	h Header
+}
+
+func newDecoder(r io.Reader) (*decoder, error) {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	d := &decoder{r: br}
+	var m [2]byte
+	if _, err := io.ReadFull(br, m[:]); err != nil || m[0] != 'P' {
+		return nil, ErrFormat
+	}
+	switch m[1] {
+	case '1', '2', '4', '5':
+	case '3', '6', '7':
+		return nil, ErrUnsupported
+	default:
+		return nil, ErrFormat
+	}
+	d.h.Magic = m[1]
+
+	var err error
+	if d.h.Width, err = d.int(); err != nil {
+		return nil, ErrHeader
+	}
+	if d.h.Height, err = d.int(); err != nil {
+		return nil, ErrHeader
+	}
+	d.h.MaxVal = 1
+	if !d.h.Bitmap() {
+		if d.h.MaxVal, err = d.int(); err != nil {
+			return nil, ErrHeader
+		}
+	}
+	if d.h.Width <= 0 || d.h.Height <= 0 || d.h.Width > maxSize || d.h.Height > maxSize ||
+		d.h.MaxVal <= 0 || d.h.MaxVal > 0xFFFF {
+		return nil, ErrHeader
+	}
+	// A single whitespace byte separates the header from binary data.
+	if !d.h.Plain() {
+		if c, err := br.ReadByte(); err != nil || !isSpace(c) {
+			return nil, ErrHeader
+		}
+	}
+	return d, nil
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
+
+// skip consumes whitespace and # comments.
+func (d *decoder) skip() error {
+	for {
+		c, err := d.r.ReadByte()
+		if err != nil {
+			return err
+		}
+		switch {
+		case c == '#':
+			if _, err := d.r.ReadString('\n'); err != nil {
+				return err
+			}
+		case !isSpace(c):
+			return d.r.UnreadByte()
+		}
+	}
+}
+
+// int reads an ASCII decimal number, leaving the byte after it unread.
+func (d *decoder) int() (int, error) {
+	if err := d.skip(); err != nil {
+		return 0, err
+	}
+	n, digits := 0, 0
+	for {
+		c, err := d.r.ReadByte()
+		if err != nil {
+			if err == io.EOF && digits > 0 {
+				return n, nil
+			}
+			return 0, err
+		}
+		if c < '0' || c > '9' {
+			d.r.UnreadByte()
+			if digits == 0 {
+				return 0, ErrData
+			}
+			return n, nil
+		}
+		if n > 1<<20 {
+			return 0, ErrData
+		}
+		n = n*10 + int(c-'0')
+		digits++
+	}
+}
+
+// bit reads one P1 pixel; digits need not be separated.
+func (d *decoder) bit() (bool, error) {
+	if err := d.skip(); err != nil {
+		return false, err
+	}
+	c, err := d.r.ReadByte()
+	if err != nil || (c != '0' && c != '1') {
+		return false, ErrData
+	}
+	return c == '1', nil
+}
+
+// sample reads one gray sample scaled to 0-255.
+func (d *decoder) sample() (uint8, error) {
+	var v int
+	switch {
+	case d.h.Magic == '2':
+		n, err := d.int()
+		if err != nil {
+			return 0, ErrData
+		}
+		v = n
+	case d.h.MaxVal < 256:
+		c, err := d.r.ReadByte()
+		if err != nil {
+			return 0, ErrData
+		}
+		v = int(c)
+	default:
+		var b [2]byte
+		if _, err := io.ReadFull(d.r, b[:]); err != nil {
+			return 0, ErrData
+		}
+		v = int(b[0])<<8 | int(b[1])
+	}
+	if v > d.h.MaxVal {
+		return 0, ErrData
+	}
+	return uint8((v*255 + d.h.MaxVal/2) / d.h.MaxVal), nil
+}
+
+// row decodes one row as ink (bitmaps) or levels (graymaps) via set.
+func (d *decoder) row(buf []byte, set func(x int, level uint8)) error {
+	h := d.h
+	switch h.Magic {
+	case '4':
+		if _, err := io.ReadFull(d.r, buf); err != nil {
+			return ErrData
+		}
+		for x := 0; x < h.Width; x++ {
+			set(x, 15*(buf[x>>3]>>(7-x&7)&1))
+		}
+	case '1':
+		for x := 0; x < h.Width; x++ {
+			on, err := d.bit()
+			if err != nil {
+				return ErrData
+			}
+			if on {
+				set(x, 15)
+			} else {
+				set(x, 0)
+			}
+		}
+	default:
+		for x := 0; x < h.Width; x++ {
+			g, err := d.sample()
+			if err != nil {
+				return err
+			}
+			set(x, epd47.GrayToLevel(g))
+		}
+	}
+	return nil
+}
+
+// DecodeHeader reads only the header of a PBM or PGM image.
+func DecodeHeader(r io.Reader) (Header, error) {
+	d, err := newDecoder(r)
+	if err != nil {
+		return Header{}, err
+	}
+	return d.h, nil
+}
+
+// Decode1bpp reads a PBM or PGM image into a 1bpp bitmap. Gray pixels at
+// level 8 or darker are inked. P4 rows are copied as they are.
+func Decode1bpp(r io.Reader) (*epd47.Bitmap1bpp, error) {
+	d, err := newDecoder(r)
+	if err != nil {
+		return nil, err
+	}
+	b := epd47.NewBitmap1bpp(d.h.Width, d.h.Height)
+	if d.h.Magic == '4' {
+		if _, err := io.ReadFull(d.r, b.Pix); err != nil {
+			return nil, ErrData
+		}
+		return b, nil
+	}
+	buf := make([]byte, b.Stride)
+	for y := 0; y < b.Height; y++ {
+		err := d.row(buf, func(x int, l uint8) { b.Set(x, y, l >= 8) })
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// Decode4bpp reads a PBM or PGM image into a 4bpp bitmap. PBM black maps
+// to level 15.
+func Decode4bpp(r io.Reader) (*epd47.Bitmap4bpp, error) {
+	d, err := newDecoder(r)
+	if err != nil {
+		return nil, err
+	}
+	b := epd47.NewBitmap4bpp(d.h.Width, d.h.Height)
+	buf := make([]byte, (d.h.Width+7)/8)
+	for y := 0; y < b.Height; y++ {
+		err := d.row(buf, func(x int, l uint8) { b.SetLevel(x, y, l) })
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// Encode1bpp writes b as a binary PBM (P4), or a plain one (P1) if plain
+// is set.
+func Encode1bpp(w io.Writer, b *epd47.Bitmap1bpp, plain bool) error {
+	bw := bufio.NewWriter(w)
+	if !plain {
+		fmt.Fprintf(bw, "P4\n%d %d\n", b.Width, b.Height)
+		for y := 0; y < b.Height; y++ {
+			bw.Write(b.Pix[y*b.Stride : y*b.Stride+(b.Width+7)/8])
+		}
+		return bw.Flush()
+	}
+	fmt.Fprintf(bw, "P1\n%d %d\n", b.Width, b.Height)
+	for y := 0; y < b.Height; y++ {
+		for x := 0; x < b.Width; x++ {
+			c := byte('0')
+			if b.Get(x, y) {
+				c = '1'
+			}
+			bw.WriteByte(c)
+			// Plain PBM lines should stay under 70 characters.
+			if x%64 == 63 || x == b.Width-1 {
+				bw.WriteByte('\n')
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+// Encode4bpp writes b as a binary PGM (P5), or a plain one (P2) if plain
+// is set, with maxval 255.
+func Encode4bpp(w io.Writer, b *epd47.Bitmap4bpp, plain bool) error {
+	bw := bufio.NewWriter(w)
+	if !plain {
+		fmt.Fprintf(bw, "P5\n%d %d\n255\n", b.Width, b.Height)
+		for y := 0; y < b.Height; y++ {
+			for x := 0; x < b.Width; x++ {
+				bw.WriteByte(epd47.LevelToGray(b.Level(x, y)).Y)
+			}
+		}
+		return bw.Flush()
+	}
+	fmt.Fprintf(bw, "P2\n%d %d\n255\n", b.Width, b.Height)
+	for y := 0; y < b.Height; y++ {
+		for x := 0; x < b.Width; x++ {
+			sep := byte(' ')
+			if x%16 == 15 || x == b.Width-1 {
+				sep = '\n'
+			}
+			fmt.Fprintf(bw, "%d%c", epd47.LevelToGray(b.Level(x, y)).Y, sep)
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,127 @@
+package netpbm
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+)
+
+func TestDecodePBM(t *testing.T) {
+	// The same 10x2 image as plain (with comments and packed digits) and raw.
+	plain := "P1\n# a comment\n10 2\n1 0 0 0 0 0 0 0 0 1\n0100000000\n"
+	raw := "P4 10 2\n\x80\x40\x40\x00"
+	for _, src := range []string{plain, raw} {
+		b, err := Decode1bpp(strings.NewReader(src))
+		if err != nil {
+			t.Fatalf("Decode1bpp failed: %v", err)
+		}
+		if !b.Get(0, 0) || !b.Get(9, 0) || !b.Get(1, 1) || b.Get(1, 0) {
+			t.Errorf("Unexpected pixels for %q: % x", src[:2], b.Pix)
+		}
+
+		g, err := Decode4bpp(strings.NewReader(src))
+		if err != nil {
+			t.Fatalf("Decode4bpp failed: %v", err)
+		}
+		if g.Level(0, 0) != 15 || g.Level(1, 0) != 0 {
+			t.Errorf("Expected PBM black at level 15, got %d %d", g.Level(0, 0), g.Level(1, 0))
+		}
+	}
+}
+
+func TestDecodePGM(t *testing.T) {
+	// maxval 15 maps straight onto levels (inverted: 15 is white).
+	b, err := Decode4bpp(strings.NewReader("P2 4 1 15\n15 10 5 0\n"))
+	if err != nil {
+		t.Fatalf("Decode4bpp failed: %v", err)
+	}
+	for x, want := range []uint8{0, 5, 10, 15} {
+		if b.Level(x, 0) != want {
+			t.Errorf("Pixel %d: expected level %d, got %d", x, want, b.Level(x, 0))
+		}
+	}
+
+	// 16-bit raw samples.
+	b, err = Decode4bpp(strings.NewReader("P5 2 1 65535\n\xff\xff\x00\x00"))
+	if err != nil {
+		t.Fatalf("Decode4bpp failed: %v", err)
+	}
+	if b.Level(0, 0) != 0 || b.Level(1, 0) != 15 {
+		t.Errorf("Unexpected 16-bit levels %d %d", b.Level(0, 0), b.Level(1, 0))
+	}
+
+	// Gray thresholds to 1bpp.
+	m, err := Decode1bpp(strings.NewReader("P5 3 1 255\n\x00\x80\xff"))
+	if err != nil {
+		t.Fatalf("Decode1bpp failed: %v", err)
+	}
+	if !m.Get(0, 0) || m.Get(2, 0) {
+		t.Errorf("Unexpected thresholded pixels % x", m.Pix)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	g := epd47.NewBitmap4bpp(21, 5)
+	for y := 0; y < g.Height; y++ {
+		for x := 0; x < g.Width; x++ {
+			g.SetLevel(x, y, uint8(x+y)&15)
+		}
+	}
+	m := epd47.NewBitmap1bpp(70, 3)
+	for x := 0; x < m.Width; x += 3 {
+		m.Set(x, x%3, true)
+	}
+
+	for _, plain := range []bool{false, true} {
+		var buf bytes.Buffer
+		if err := Encode4bpp(&buf, g, plain); err != nil {
+			t.Fatalf("Encode4bpp failed: %v", err)
+		}
+		got, err := Decode4bpp(&buf)
+		if err != nil || !bytes.Equal(got.Pix, g.Pix) {
+			t.Errorf("PGM plain=%v round trip failed (%v)", plain, err)
+		}
+
+		buf.Reset()
+		if err := Encode1bpp(&buf, m, plain); err != nil {
+			t.Fatalf("Encode1bpp failed: %v", err)
+		}
+		if plain {
+			for _, line := range strings.Split(buf.String(), "\n") {
+				if len(line) > 70 {
+					t.Errorf("Plain PBM line too long: %d", len(line))
+				}
+			}
+		}
+		got1, err := Decode1bpp(&buf)
+		if err != nil || !bytes.Equal(got1.Pix, m.Pix) {
+			t.Errorf("PBM plain=%v round trip failed (%v)", plain, err)
+		}
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	cases := map[string]error{
+		"":                         ErrFormat,
+		"GIF89a":                   ErrFormat,
+		"P6 1 1 255\n\x00\x00\x00": ErrUnsupported,
+		"P5 0 1 255\n":             ErrHeader,
+		"P5 1 1 70000\n\x00":       ErrHeader,
+		"P5 99999 1 255\n":         ErrHeader,
+		"P4 8 2\n\x00":             ErrData,
+		"P2 2 1 15\n3 16\n":        ErrData,
+		"P1 2 1\n1 2\n":            ErrData,
+	}
+	for src, want := range cases {
+		if _, err := Decode4bpp(strings.NewReader(src)); err != want {
+			t.Errorf("%q: expected %v, got %v", src, want, err)
+		}
+	}
+
+	h, err := DecodeHeader(strings.NewReader("P5\n#c\n640 480\n255\n"))
+	if err != nil || h.Width != 640 || h.Height != 480 || h.MaxVal != 255 || h.Bitmap() || h.Plain() {
+		t.Errorf("Unexpected header %+v (%v)", h, err)
+	}
+}
@@ -0,0 +1,251 @@
+package qrcode
+
+// matrix is a symbol under construction.
+type matrix struct {
+	size     int
+	dark     []bool
+	function []bool // finder, timing, alignment, format and version modules
+}
+
+func (m *matrix) set(x, y int, dark bool) {
+	m.dark[y*m.size+x] = dark
+	m.function[y*m.size+x] = true
+}
+
+// build draws the function patterns and codewords and applies the best
+// mask.
+func (c *Code) build(cw []byte) {
+	m := &matrix{size: c.Size, dark: make([]bool, c.Size*c.Size), function: make([]bool, c.Size*c.Size)}
+	m.drawFunctions(c.Version)
+	m.drawCodewords(cw)
+
+	best, bestScore := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		m.applyMask(mask)
+		m.drawFormat(c.Level, mask)
+		if s := m.penalty(); bestScore < 0 || s < bestScore {
+			best, bestScore = mask, s
+		}
+		m.applyMask(mask) // masks are their own inverse
+	}
+	m.applyMask(best)
+	m.drawFormat(c.Level, best)
+	c.Mask = best
+	c.modules = m.dark
+}
+
+// drawFunctions draws everything but the codewords, reserving the format
+// areas for drawFormat.
+func (m *matrix) drawFunctions(version int) {
+	n := m.size
+	for i := 0; i < n; i++ {
+		m.set(6, i, i%2 == 0)
+		m.set(i, 6, i%2 == 0)
+	}
+	m.drawFinder(3, 3)
+	m.drawFinder(n-4, 3)
+	m.drawFinder(3, n-4)
+
+	ps := alignmentPositions(version)
+	for i, x := range ps {
+		for j, y := range ps {
+			// Skip the three corners taken by finder patterns.
+			if (i == 0 && j == 0) || (i == 0 && j == len(ps)-1) || (i == len(ps)-1 && j == 0) {
+				continue
+			}
+			for dy := -2; dy <= 2; dy++ {
+				for dx := -2; dx <= 2; dx++ {
+					m.set(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+				}
+			}
+		}
+	}
+
+	m.drawFormat(L, 0) // reserve; overwritten with the real mask later
+
+	if version >= 7 {
+		rem := version
+		for i := 0; i < 12; i++ {
+			rem = rem<<1 ^ (rem>>11)*0x1F25
+		}
+		bits := version<<12 | rem
+		for i := 0; i < 18; i++ {
+			dark := bits>>uint(i)&1 != 0
+			a, b := n-11+i%3, i/3
+			m.set(a, b, dark)
+			m.set(b, a, dark)
+		}
+	}
+}
+
+// drawFinder draws a finder pattern and its separator centered at x,y.
+func (m *matrix) drawFinder(x, y int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			xx, yy := x+dx, y+dy
+			if xx < 0 || yy < 0 || xx >= m.size || yy >= m.size {
+				continue
+			}
+			d := max(abs(dx), abs(dy))
+			m.set(xx, yy, d != 2 && d != 4)
+		}
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// drawFormat writes both copies of the format information and the dark
+// module.
+func (m *matrix) drawFormat(l Level, mask int) {
+	data := l.formatBits()<<3 | mask
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = rem<<1 ^ (rem>>9)*0x537
+	}
+	bits := (data<<10 | rem) ^ 0x5412
+	bit := func(i int) bool { return bits>>uint(i)&1 != 0 }
+
+	n := m.size
+	for i := 0; i <= 5; i++ {
+		m.set(8, i, bit(i))
+	}
+	m.set(8, 7, bit(6))
+	m.set(8, 8, bit(7))
+	m.set(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		m.set(14-i, 8, bit(i))
+	}
+	for i := 0; i < 8; i++ {
+		m.set(n-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		m.set(8, n-15+i, bit(i))
+	}
+	m.set(8, n-8, true)
+}
+
+// drawCodewords places the codeword bits in the two-column zigzag from the
+// bottom right, skipping function modules; remainder bits stay light.
+func (m *matrix) drawCodewords(cw []byte) {
+	n := m.size
+	i := 0
+	for right := n - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5 // skip the vertical timing pattern
+		}
+		for vert := 0; vert < n; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = n - 1 - vert // upwards
+				}
+				if m.function[y*n+x] || i >= len(cw)*8 {
+					continue
+				}
+				m.dark[y*n+x] = cw[i>>3]>>(7-uint(i&7))&1 != 0
+				i++
+			}
+		}
+	}
+}
+
+// maskBit reports whether mask inverts the module at x,y.
+func maskBit(mask, x, y int) bool {
+	switch mask {
+	case 0:
+		return (x+y)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (x+y)%3 == 0
+	case 4:
+		return (x/3+y/2)%2 == 0
+	case 5:
+		return x*y%2+x*y%3 == 0
+	case 6:
+		return (x*y%2+x*y%3)%2 == 0
+	}
+	return ((x+y)%2+x*y%3)%2 == 0
+}
+
+// applyMask inverts the non-function modules selected by mask.
+func (m *matrix) applyMask(mask int) {
+	n := m.size
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			if !m.function[y*n+x] && maskBit(mask, x, y) {
+				m.dark[y*n+x] = !m.dark[y*n+x]
+			}
+		}
+	}
+}
+
+// penalty scores the symbol with the four rules of the standard; lower
+// is better.
+func (m *matrix) penalty() int {
+	n := m.size
+	at := func(x, y int, transpose bool) bool {
+		if transpose {
+			x, y = y, x
+		}
+		return m.dark[y*n+x]
+	}
+	score := 0
+	for _, tr := range []bool{false, true} {
+		for y := 0; y < n; y++ {
+			// Rule 1: runs of five or more same-colored modules.
+			run := 1
+			for x := 1; x <= n; x++ {
+				if x < n && at(x, y, tr) == at(x-1, y, tr) {
+					run++
+					continue
+				}
+				if run >= 5 {
+					score += run - 2
+				}
+				run = 1
+			}
+			// Rule 3: 1:1:3:1:1 finder-like patterns with four light
+			// modules on one side; outside the symbol counts as light.
+			var w uint16
+			for x := -4; x < n+4; x++ {
+				w = (w<<1 | b2u(x >= 0 && x < n && at(x, y, tr))) & 0x7FF
+				if x >= 6 && (w == 0x5D0 || w == 0x05D) {
+					score += 40
+				}
+			}
+		}
+	}
+	dark := 0
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			d := m.dark[y*n+x]
+			if d {
+				dark++
+			}
+			// Rule 2: 2x2 blocks of one color.
+			if x+1 < n && y+1 < n && d == m.dark[y*n+x+1] && d == m.dark[(y+1)*n+x] && d == m.dark[(y+1)*n+x+1] {
+				score += 3
+			}
+		}
+	}
+	// Rule 4: deviation of the dark share from 50% in 5% steps.
+	total := n * n
+	k := (abs(dark*20-total*10)+total-1)/total - 1
+	return score + k*10
+}
+
+func b2u(b bool) uint16 {
+	if b {
+		return 1
+	}
+	return 0
+}
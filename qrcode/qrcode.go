@@ -0,0 +1,317 @@
+// Package qrcode encodes QR codes (ISO/IEC 18004, model 2) for the epd47
+// panel: versions 1 to 40, all four error correction levels, and numeric,
+// alphanumeric and byte mode. Codes render to a Bitmap1bpp at an integer
+// module size with a quiet zone, ready for Draw1bpp.
+//
+//	c, err := qrcode.Encode("WIFI:T:WPA;S:greenhouse;P:secret;;", qrcode.M)
+//	if err != nil {
+//		return err
+//	}
+//	bm := c.Bitmap(6, 4) // 6px modules, 4-module quiet zone
+//	d.Draw1bpp(40, 40, bm.Width, bm.Height, bm.Pix, 10)
+//
+// The data is encoded as one segment in the most compact mode that can
+// hold all of it, in the smallest version that fits, and the mask with the
+// lowest penalty score is chosen as the standard requires.
+package qrcode
+
+import (
+	"errors"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+)
+
+// Errors returned by Encode.
+var (
+	ErrTooLong = errors.New("qrcode: data does not fit in version 40")
+	ErrMode    = errors.New("qrcode: data cannot be encoded in the requested mode")
+	ErrLevel   = errors.New("qrcode: invalid error correction level")
+	ErrVersion = errors.New("qrcode: version out of range 1-40")
+)
+
+// Level is the error correction level, recovering about 7% (L), 15% (M),
+// 25% (Q) or 30% (H) of the codewords.
+type Level uint8
+
+const (
+	L Level = iota
+	M
+	Q
+	H
+)
+
+// String returns the level's letter.
+func (l Level) String() string {
+	if l > H {
+		return "?"
+	}
+	return "LMQH"[l : l+1]
+}
+
+// formatBits returns the level's 2-bit value in the format information.
+func (l Level) formatBits() int { return [4]int{1, 0, 3, 2}[l] }
+
+// Mode is the segment encoding.
+type Mode uint8
+
+const (
+	Auto         Mode = iota // most compact mode for the data
+	Numeric                  // digits 0-9, 3.3 bits per character
+	Alphanumeric             // 0-9, A-Z, space and $%*+-./: at 5.5 bits
+	Byte                     // any bytes, usually UTF-8, at 8 bits
+)
+
+const alphanumeric = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ $%*+-./:"
+
+// Options tunes EncodeOptions.
+type Options struct {
+	Level Level
+	Mode  Mode
+	// MinVersion is the smallest version to use, 0 or 1 for any. Fixing
+	// the version keeps the symbol size stable as the data changes.
+	MinVersion int
+}
+
+// Code is an encoded QR symbol.
+type Code struct {
+	Version int
+	Level   Level
+	Mode    Mode
+	Mask    int // 0-7
+	Size    int // modules per side, 17 + 4*Version
+
+	modules []bool // dark modules, row-major
+}
+
+// Encode encodes data at level l in the most compact mode and the smallest
+// version that fit.
+func Encode(data string, l Level) (*Code, error) {
+	return EncodeOptions(data, Options{Level: l})
+}
+
+// EncodeOptions encodes data with explicit options.
+func EncodeOptions(data string, o Options) (*Code, error) {
+	if o.Level > H {
+		return nil, ErrLevel
+	}
+	if o.MinVersion < 0 || o.MinVersion > 40 {
+		return nil, ErrVersion
+	}
+	mode := o.Mode
+	if mode == Auto {
+		mode = modeFor(data)
+	} else if !fits(data, mode) {
+		return nil, ErrMode
+	}
+	for v := max(o.MinVersion, 1); v <= 40; v++ {
+		bits := segmentBits(data, mode, v)
+		if bits <= dataCodewords(v, o.Level)*8 {
+			c := &Code{Version: v, Level: o.Level, Mode: mode, Size: 17 + 4*v}
+			c.build(codewords(data, mode, v, o.Level))
+			return c, nil
+		}
+	}
+	return nil, ErrTooLong
+}
+
+// modeFor returns the most compact mode that can hold data.
+func modeFor(data string) Mode {
+	if fits(data, Numeric) {
+		return Numeric
+	}
+	if fits(data, Alphanumeric) {
+		return Alphanumeric
+	}
+	return Byte
+}
+
+// fits reports whether every byte of data is encodable in mode.
+func fits(data string, mode Mode) bool {
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		switch mode {
+		case Numeric:
+			if c < '0' || c > '9' {
+				return false
+			}
+		case Alphanumeric:
+			if indexByte(alphanumeric, c) < 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func indexByte(s string, c byte) int {
+	for i := 0; i < len(s); i++ {
+		if s[i] == c {
+			return i
+		}
+	}
+	return -1
+}
+
+// countBits returns the width of the character count field.
+func countBits(mode Mode, version int) int {
+	i := 0
+	if version >= 27 {
+		i = 2
+	} else if version >= 10 {
+		i = 1
+	}
+	switch mode {
+	case Numeric:
+		return [3]int{10, 12, 14}[i]
+	case Alphanumeric:
+		return [3]int{9, 11, 13}[i]
+	}
+	return [3]int{8, 16, 16}[i]
+}
+
+// segmentBits returns the length of the encoded segment in bits, or more
+// than any capacity if the count does not fit its field.
+func segmentBits(data string, mode Mode, version int) int {
+	n := len(data)
+	if n >= 1<<countBits(mode, version) {
+		return 1 << 30
+	}
+	bits := 4 + countBits(mode, version)
+	switch mode {
+	case Numeric:
+		bits += n/3*10 + [3]int{0, 4, 7}[n%3]
+	case Alphanumeric:
+		bits += n/2*11 + n%2*6
+	default:
+		bits += n * 8
+	}
+	return bits
+}
+
+// bitBuffer accumulates bits MSB first.
+type bitBuffer struct {
+	b []byte
+	n int
+}
+
+func (bb *bitBuffer) add(v, bits int) {
+	for i := bits - 1; i >= 0; i-- {
+		if bb.n%8 == 0 {
+			bb.b = append(bb.b, 0)
+		}
+		if v>>uint(i)&1 != 0 {
+			bb.b[bb.n/8] |= 0x80 >> uint(bb.n%8)
+		}
+		bb.n++
+	}
+}
+
+// dataBytes returns the padded data codewords of a version.
+func dataBytes(data string, mode Mode, version int, l Level) []byte {
+	var bb bitBuffer
+	bb.add([4]int{0, 1, 2, 4}[mode], 4)
+	bb.add(len(data), countBits(mode, version))
+	switch mode {
+	case Numeric:
+		for i := 0; i < len(data); i += 3 {
+			j := min(i+3, len(data))
+			v := 0
+			for _, c := range []byte(data[i:j]) {
+				v = v*10 + int(c-'0')
+			}
+			bb.add(v, [4]int{0, 4, 7, 10}[j-i])
+		}
+	case Alphanumeric:
+		for i := 0; i < len(data); i += 2 {
+			v := indexByte(alphanumeric, data[i])
+			if i+1 < len(data) {
+				bb.add(v*45+indexByte(alphanumeric, data[i+1]), 11)
+			} else {
+				bb.add(v, 6)
+			}
+		}
+	default:
+		for i := 0; i < len(data); i++ {
+			bb.add(int(data[i]), 8)
+		}
+	}
+
+	capacity := dataCodewords(version, l) * 8
+	bb.add(0, min(4, capacity-bb.n)) // terminator
+	bb.add(0, (8-bb.n%8)%8)
+	for pad := 0xEC; bb.n < capacity; pad ^= 0xEC ^ 0x11 {
+		bb.add(pad, 8)
+	}
+	return bb.b
+}
+
+// codewords returns the final interleaved data and error correction
+// codewords of a version.
+func codewords(data string, mode Mode, version int, l Level) []byte {
+	d := dataBytes(data, mode, version, l)
+	blocks := int(eccBlocks[l][version])
+	ecc := int(eccPerBlock[l][version])
+	raw := rawModules(version) / 8
+	short := blocks - raw%blocks
+	shortLen := raw/blocks - ecc // data codewords in a short block
+
+	gen := rsGenerator(ecc)
+	dataBlocks := make([][]byte, blocks)
+	eccData := make([][]byte, blocks)
+	for i, k := 0, 0; i < blocks; i++ {
+		n := shortLen
+		if i >= short {
+			n++
+		}
+		dataBlocks[i] = d[k : k+n]
+		eccData[i] = rsRemainder(d[k:k+n], gen)
+		k += n
+	}
+
+	out := make([]byte, 0, raw)
+	for i := 0; i <= shortLen; i++ {
+		for j := range dataBlocks {
+			if i < len(dataBlocks[j]) {
+				out = append(out, dataBlocks[j][i])
+			}
+		}
+	}
+	for i := 0; i < ecc; i++ {
+		for j := range eccData {
+			out = append(out, eccData[j][i])
+		}
+	}
+	return out
+}
+
+// Black reports whether the module at column x, row y is dark. Modules
+// outside the symbol are light.
+func (c *Code) Black(x, y int) bool {
+	if x < 0 || y < 0 || x >= c.Size || y >= c.Size {
+		return false
+	}
+	return c.modules[y*c.Size+x]
+}
+
+// Bitmap renders the code with module x module pixels per module and a
+// light border of quiet modules on every side; the standard asks for 4.
+func (c *Code) Bitmap(module, quiet int) *epd47.Bitmap1bpp {
+	module = max(module, 1)
+	quiet = max(quiet, 0)
+	n := (c.Size + 2*quiet) * module
+	bm := epd47.NewBitmap1bpp(n, n)
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if !c.modules[y*c.Size+x] {
+				continue
+			}
+			x0, y0 := (x+quiet)*module, (y+quiet)*module
+			for j := 0; j < module; j++ {
+				for i := 0; i < module; i++ {
+					bm.Set(x0+i, y0+j, true)
+				}
+			}
+		}
+	}
+	return bm
+}
@@ -0,0 +1,349 @@
+package qrcode
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+)
+
+func TestKnownCodewords(t *testing.T) {
+	cases := []struct {
+		data    string
+		version int
+		level   Level
+		want    string // data codewords then error correction codewords
+	}{
+		// The 1-M example of ISO/IEC 18004 Annex I.
+		{"01234567", 1, M, "16 32 12 86 97 128 236 17 236 17 236 17 236 17 236 17  165 36 212 193 237 54 199 135 44 85"},
+		// HELLO WORLD in version 1-M: 16 data and 10 EC codewords.
+		{"HELLO WORLD", 1, M, "32 91 11 120 209 114 220 77 67 64 236 17 236 17 236 17  196 35 39 119 235 215 231 226 93 23"},
+		// And in 1-Q: 13 data and 13 EC codewords.
+		{"HELLO WORLD", 1, Q, "32 91 11 120 209 114 220 77 67 64 236 17 236  168 72 22 82 217 54 156 0 46 15 180 122 16"},
+	}
+	for _, c := range cases {
+		got := codewords(c.data, modeFor(c.data), c.version, c.level)
+		var want []byte
+		for _, f := range strings.Fields(c.want) {
+			var b byte
+			fmt.Sscan(f, &b)
+			want = append(want, b)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%q %d-%v:\nexpected %v\n     got %v", c.data, c.version, c.level, want, got)
+		}
+	}
+}
+
+func TestTables(t *testing.T) {
+	// Data capacities from the standard's tables.
+	caps := map[[2]int]int{
+		{1, int(L)}: 19, {1, int(H)}: 9, {10, int(M)}: 216, {20, int(H)}: 385,
+		{27, int(Q)}: 808, {40, int(L)}: 2956, {40, int(M)}: 2334, {40, int(Q)}: 1666, {40, int(H)}: 1276,
+	}
+	for k, want := range caps {
+		if got := dataCodewords(k[0], Level(k[1])); got != want {
+			t.Errorf("Version %d-%v: expected %d data codewords, got %d", k[0], Level(k[1]), want, got)
+		}
+	}
+	for v, want := range map[int][]int{2: {6, 18}, 7: {6, 22, 38}, 32: {6, 34, 60, 86, 112, 138}, 40: {6, 30, 58, 86, 114, 142, 170}} {
+		if got := alignmentPositions(v); fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("Version %d: expected alignment at %v, got %v", v, want, got)
+		}
+	}
+
+	// Format information for M and L with mask 0, version information for 7.
+	c, _ := EncodeOptions("1", Options{Level: M, MinVersion: 7})
+	if f := readFormat(c.Black, c.Size) ^ 0x5412; f>>10 != uint16(M.formatBits()<<3|c.Mask) {
+		t.Errorf("Unexpected format information %015b", f)
+	}
+	var m matrix
+	m.size, m.dark, m.function = 21, make([]bool, 441), make([]bool, 441)
+	for l, want := range map[Level]uint16{M: 0x5412, L: 0x77C4} {
+		m.drawFormat(l, 0)
+		if got := readFormat(func(x, y int) bool { return m.dark[y*21+x] }, 21); got != want {
+			t.Errorf("%v mask 0: expected format %015b, got %015b", l, want, got)
+		}
+	}
+	v := 0
+	for i := 17; i >= 0; i-- {
+		v = v<<1 | int(b2u(c.Black(c.Size-11+i%3, i/3)))
+	}
+	if v != 0x07C94 {
+		t.Errorf("Expected version information 0x07C94, got %#x", v)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	long := strings.Repeat("epd47 ", 400)
+	cases := []struct {
+		data string
+		mode Mode
+	}{
+		{"", Numeric},
+		{"8675309", Numeric},
+		{"HTTPS://EXAMPLE.COM/PROVISION/ABC-123", Alphanumeric},
+		{"WIFI:T:WPA;S:greenhouse;P:s3cr3t!;;", Byte},
+		{"température 21.5°C", Byte},
+		{strings.Repeat("0123456789", 300), Numeric},
+		{long[:1200], Byte},
+	}
+	for _, c := range cases {
+		for l := L; l <= H; l++ {
+			code, err := Encode(c.data, l)
+			if err == ErrTooLong {
+				continue
+			}
+			if err != nil {
+				t.Fatalf("%.20q %v: %v", c.data, l, err)
+			}
+			if code.Mode != c.mode {
+				t.Errorf("%.20q: expected mode %d, got %d", c.data, c.mode, code.Mode)
+			}
+			got, err := decode(code.Bitmap(2, 4), 2, 4)
+			if err != nil || got != c.data {
+				t.Errorf("%.20q v%d-%v mask %d: decoded %.20q (%v)", c.data, code.Version, l, code.Mask, got, err)
+			}
+		}
+	}
+
+	// Every version decodes, with the version forced.
+	for v := 1; v <= 40; v++ {
+		code, err := EncodeOptions("VERSION TEST", Options{Level: Level(v % 4), MinVersion: v})
+		if err != nil || code.Version != v || code.Size != 17+4*v {
+			t.Fatalf("Version %d: %v", v, err)
+		}
+		if got, err := decode(code.Bitmap(1, 0), 1, 0); err != nil || got != "VERSION TEST" {
+			t.Errorf("Version %d-%v: decoded %q (%v)", v, code.Level, got, err)
+		}
+	}
+}
+
+func TestEncodeErrors(t *testing.T) {
+	if _, err := Encode(strings.Repeat("x", 3000), L); err != ErrTooLong {
+		t.Errorf("Expected ErrTooLong, got %v", err)
+	}
+	if _, err := EncodeOptions("abc", Options{Mode: Alphanumeric}); err != ErrMode {
+		t.Errorf("Expected ErrMode, got %v", err)
+	}
+	if _, err := EncodeOptions("1", Options{Level: 4}); err != ErrLevel {
+		t.Errorf("Expected ErrLevel, got %v", err)
+	}
+	if _, err := EncodeOptions("1", Options{MinVersion: 41}); err != ErrVersion {
+		t.Errorf("Expected ErrVersion, got %v", err)
+	}
+	// 7089 digits is the numeric capacity of 40-L.
+	if _, err := Encode(strings.Repeat("7", 7089), L); err != nil {
+		t.Errorf("Expected the maximum numeric payload to fit, got %v", err)
+	}
+	c, _ := Encode("A", L)
+	if bm := c.Bitmap(3, 4); bm.Width != (21+8)*3 || !bm.Get(12, 12) || bm.Get(11, 11) {
+		t.Error("Expected the top-left finder inside a 4-module quiet zone")
+	}
+}
+
+// The decoder below reads a rendered symbol back independently of the
+// encoder's placement code, sharing only the block and alignment tables
+// checked in TestTables.
+
+// readFormat reads the format information copy around the top-left finder.
+func readFormat(black func(x, y int) bool, n int) uint16 {
+	var f uint16
+	bit := func(i int, x, y int) {
+		if black(x, y) {
+			f |= 1 << uint(i)
+		}
+	}
+	for i := 0; i <= 5; i++ {
+		bit(i, 8, i)
+	}
+	bit(6, 8, 7)
+	bit(7, 8, 8)
+	bit(8, 7, 8)
+	for i := 9; i < 15; i++ {
+		bit(i, 14-i, 8)
+	}
+	return f
+}
+
+// decode samples the modules of bm and returns the encoded text.
+func decode(bm *epd47.Bitmap1bpp, module, quiet int) (string, error) {
+	n := bm.Width/module - 2*quiet
+	if (n-17)%4 != 0 || n < 21 {
+		return "", errors.New("bad size")
+	}
+	version := (n - 17) / 4
+	black := func(x, y int) bool {
+		return bm.Get((x+quiet)*module+module/2, (y+quiet)*module+module/2)
+	}
+
+	// Nearest valid format word.
+	f := readFormat(black, n)
+	level, mask, best := Level(0), 0, 99
+	for l := L; l <= H; l++ {
+		for k := 0; k < 8; k++ {
+			var m matrix
+			m.size, m.dark, m.function = 21, make([]bool, 441), make([]bool, 441)
+			m.drawFormat(l, k)
+			w := readFormat(func(x, y int) bool { return m.dark[y*21+x] }, 21)
+			d := 0
+			for x := w ^ f; x != 0; x &= x - 1 {
+				d++
+			}
+			if d < best {
+				level, mask, best = l, k, d
+			}
+		}
+	}
+	if best > 3 {
+		return "", errors.New("unreadable format information")
+	}
+
+	// Function modules.
+	fn := make([]bool, n*n)
+	mark := func(x0, y0, x1, y1 int) {
+		for y := max(y0, 0); y < min(y1, n); y++ {
+			for x := max(x0, 0); x < min(x1, n); x++ {
+				fn[y*n+x] = true
+			}
+		}
+	}
+	mark(0, 0, 9, 9)
+	mark(n-8, 0, n, 9)
+	mark(0, n-8, 9, n)
+	mark(6, 0, 7, n)
+	mark(0, 6, n, 7)
+	ps := alignmentPositions(version)
+	for _, x := range ps {
+		for _, y := range ps {
+			// Alignment patterns never overlap the finders.
+			if (x > 8 || y > 8) && (x < n-9 || y > 8) && (x > 8 || y < n-9) {
+				mark(x-2, y-2, x+3, y+3)
+			}
+		}
+	}
+	if version >= 7 {
+		mark(n-11, 0, n-8, 6)
+		mark(0, n-11, 6, n-8)
+	}
+
+	// Read the zigzag, unmasking as we go.
+	var bits []bool
+	for col := n - 1; col > 0; col -= 2 {
+		if col == 6 {
+			col--
+		}
+		up := ((n-1-col)/2)%2 == 0
+		if col < 6 {
+			up = ((n-2-col)/2)%2 == 0
+		}
+		for i := 0; i < n; i++ {
+			y := i
+			if up {
+				y = n - 1 - i
+			}
+			for _, x := range []int{col, col - 1} {
+				if !fn[y*n+x] {
+					bits = append(bits, black(x, y) != maskBit(mask, x, y))
+				}
+			}
+		}
+	}
+	raw := make([]byte, len(bits)/8)
+	for i := range raw {
+		for j := 0; j < 8; j++ {
+			if bits[i*8+j] {
+				raw[i] |= 0x80 >> uint(j)
+			}
+		}
+	}
+
+	// De-interleave and check every block's syndromes.
+	blocks := int(eccBlocks[level][version])
+	ecc := int(eccPerBlock[level][version])
+	short := blocks - len(raw)%blocks
+	shortLen := len(raw)/blocks - ecc
+	bl := make([][]byte, blocks)
+	k := 0
+	for i := 0; i <= shortLen; i++ {
+		for j := range bl {
+			if i < shortLen || j >= short {
+				bl[j] = append(bl[j], raw[k])
+				k++
+			}
+		}
+	}
+	for i := 0; i < ecc; i++ {
+		for j := range bl {
+			bl[j] = append(bl[j], raw[k])
+			k++
+		}
+	}
+	var data []byte
+	for j, b := range bl {
+		for s := 0; s < ecc; s++ {
+			// Evaluate the block polynomial at alpha^s.
+			var acc uint8
+			for _, c := range b {
+				acc = gfMul(acc, gfExp[s]) ^ c
+			}
+			if acc != 0 {
+				return "", fmt.Errorf("block %d: nonzero syndrome", j)
+			}
+		}
+		data = append(data, b[:len(b)-ecc]...)
+	}
+
+	// Parse the single segment.
+	pos := 0
+	read := func(w int) int {
+		v := 0
+		for i := 0; i < w; i++ {
+			v = v<<1 | int(data[pos>>3]>>(7-uint(pos&7))&1)
+			pos++
+		}
+		return v
+	}
+	var mode Mode
+	switch read(4) {
+	case 1:
+		mode = Numeric
+	case 2:
+		mode = Alphanumeric
+	case 4:
+		mode = Byte
+	case 0:
+		return "", nil
+	default:
+		return "", errors.New("unknown mode")
+	}
+	count := read(countBits(mode, version))
+	var out []byte
+	switch mode {
+	case Numeric:
+		for ; count >= 3; count -= 3 {
+			out = fmt.Appendf(out, "%03d", read(10))
+		}
+		if count == 2 {
+			out = fmt.Appendf(out, "%02d", read(7))
+		} else if count == 1 {
+			out = fmt.Appendf(out, "%d", read(4))
+		}
+	case Alphanumeric:
+		for ; count >= 2; count -= 2 {
+			v := read(11)
+			out = append(out, alphanumeric[v/45], alphanumeric[v%45])
+		}
+		if count == 1 {
+			out = append(out, alphanumeric[read(6)])
+		}
+	default:
+		for ; count > 0; count-- {
+			out = append(out, byte(read(8)))
+		}
+	}
+	return string(out), nil
+}
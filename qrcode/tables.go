@@ -0,0 +1,114 @@
+package qrcode
+
+// Error correction codewords per block, indexed by level (L, M, Q, H) and
+// version; index 0 is unused.
+var eccPerBlock = [4][41]uint8{
+	{0, 7, 10, 15, 20, 26, 18, 20, 24, 30, 18, 20, 24, 26, 30, 22, 24, 28, 30, 28, 28, 28, 28, 30, 30, 26, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{0, 10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26, 26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
+	{0, 13, 22, 18, 26, 18, 24, 18, 22, 20, 24, 28, 26, 24, 20, 30, 24, 28, 28, 26, 30, 28, 30, 30, 30, 30, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{0, 17, 28, 22, 16, 22, 28, 26, 26, 24, 28, 24, 28, 22, 24, 24, 30, 28, 28, 26, 28, 30, 24, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+}
+
+// Error correction blocks, indexed like eccPerBlock.
+var eccBlocks = [4][41]uint8{
+	{0, 1, 1, 1, 1, 1, 2, 2, 2, 2, 4, 4, 4, 4, 4, 6, 6, 6, 6, 7, 8, 8, 9, 9, 10, 12, 12, 12, 13, 14, 15, 16, 17, 18, 19, 19, 20, 21, 22, 24, 25},
+	{0, 1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16, 17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49},
+	{0, 1, 1, 2, 2, 4, 4, 6, 6, 8, 8, 8, 10, 12, 16, 12, 17, 16, 18, 21, 20, 23, 23, 25, 27, 29, 34, 34, 35, 38, 40, 43, 45, 48, 51, 53, 56, 59, 62, 65, 68},
+	{0, 1, 1, 2, 4, 4, 4, 5, 6, 8, 8, 11, 11, 16, 16, 18, 16, 19, 21, 25, 25, 25, 34, 30, 32, 35, 37, 40, 42, 45, 48, 51, 54, 57, 60, 63, 66, 70, 74, 77, 81},
+}
+
+// rawModules returns the number of modules of a version available for
+// codewords and remainder bits: everything but the function patterns.
+func rawModules(version int) int {
+	n := (16*version+128)*version + 64
+	if version >= 2 {
+		align := version/7 + 2
+		n -= (25*align-10)*align - 55
+		if version >= 7 {
+			n -= 36 // version information
+		}
+	}
+	return n
+}
+
+// dataCodewords returns the number of data codewords of a version and level.
+func dataCodewords(version int, l Level) int {
+	return rawModules(version)/8 - int(eccPerBlock[l][version])*int(eccBlocks[l][version])
+}
+
+// alignmentPositions returns the row and column centers of the alignment
+// patterns of a version, smallest first.
+func alignmentPositions(version int) []int {
+	if version == 1 {
+		return nil
+	}
+	n := version/7 + 2
+	step := 26
+	if version != 32 {
+		step = (version*4 + n*2 + 1) / (n*2 - 2) * 2
+	}
+	ps := make([]int, n)
+	ps[0] = 6
+	for i, p := n-1, version*4+10; i >= 1; i, p = i-1, p-step {
+		ps[i] = p
+	}
+	return ps
+}
+
+// Reed-Solomon arithmetic in GF(256) with the QR polynomial 0x11D.
+
+var gfExp, gfLog = func() (exp [512]uint8, log [256]uint8) {
+	x := 1
+	for i := 0; i < 255; i++ {
+		exp[i] = uint8(x)
+		log[x] = uint8(i)
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= 0x11D
+		}
+	}
+	for i := 255; i < 512; i++ {
+		exp[i] = exp[i-255]
+	}
+	return
+}()
+
+func gfMul(a, b uint8) uint8 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+int(gfLog[b])]
+}
+
+// rsGenerator returns the coefficients of the generator polynomial of the
+// given degree, highest power first without the leading 1.
+func rsGenerator(degree int) []uint8 {
+	g := make([]uint8, degree)
+	g[degree-1] = 1
+	root := uint8(1)
+	for i := 0; i < degree; i++ {
+		// Multiply by (x - root).
+		for j := 0; j < degree; j++ {
+			g[j] = gfMul(g[j], root)
+			if j+1 < degree {
+				g[j] ^= g[j+1]
+			}
+		}
+		root = gfMul(root, 2)
+	}
+	return g
+}
+
+// rsRemainder returns the error correction codewords of data.
+func rsRemainder(data, gen []uint8) []uint8 {
+	r := make([]uint8, len(gen))
+	for _, b := range data {
+		f := b ^ r[0]
+		copy(r, r[1:])
+		r[len(r)-1] = 0
+		for i, g := range gen {
+			r[i] ^= gfMul(g, f)
+		}
+	}
+	return r
+}
@@ -0,0 +1,153 @@
+package touch
+
+import (
+	"image"
+	"time"
+)
+
+// Kind is the type of a recognized gesture.
+type Kind uint8
+
+const (
+	None Kind = iota
+	Tap
+	LongPress
+	SwipeLeft
+	SwipeRight
+	SwipeUp
+	SwipeDown
+)
+
+// Gesture is a recognized single-finger gesture in display coordinates.
+type Gesture struct {
+	Kind       Kind
+	Start, End image.Point
+	Duration   time.Duration
+}
+
+// Default gesture thresholds, used for zero Recognizer fields.
+const (
+	DefaultLongPress = 600 * time.Millisecond
+	DefaultSlop      = 16  // pixels a tap or long press may move
+	DefaultSwipe     = 100 // pixels a swipe must travel
+)
+
+// Recognizer turns successive Read results into gestures. It follows the
+// first finger down; further fingers are ignored until it lifts. Feed it
+// every Read, also when nothing is touched, so it sees the release.
+type Recognizer struct {
+	LongPress time.Duration // hold time of a long press
+	Slop      int           // movement allowed for taps and long presses
+	Swipe     int           // distance of a swipe
+
+	down       bool
+	id         uint8
+	start, end image.Point
+	t0         time.Time
+	moved      bool // left the slop since touch-down
+	longDone   bool // LongPress already reported for this touch
+}
+
+// Update takes the touches of one Read at time now and returns the gesture
+// completed by them, if any. A tap or swipe is reported when the finger
+// lifts, a long press as soon as it has been held long enough.
+func (r *Recognizer) Update(points []Point, now time.Time) Gesture {
+	p, ok := r.track(points)
+	switch {
+	case !r.down && ok:
+		r.down, r.id, r.start, r.end, r.t0 = true, p.ID, p.Pos, p.Pos, now
+		r.moved, r.longDone = false, false
+	case r.down && ok:
+		r.end = p.Pos
+		if dist(r.start, r.end) > r.slop() {
+			r.moved = true
+		}
+		if !r.moved && !r.longDone && now.Sub(r.t0) >= r.longPress() {
+			r.longDone = true
+			return r.gesture(LongPress, now)
+		}
+	case r.down:
+		r.down = false
+		return r.gesture(r.release(), now)
+	}
+	return Gesture{}
+}
+
+// track returns the followed finger, or any finger before a touch-down.
+func (r *Recognizer) track(points []Point) (Point, bool) {
+	if !r.down {
+		if len(points) == 0 {
+			return Point{}, false
+		}
+		return points[0], true
+	}
+	for _, p := range points {
+		if p.ID == r.id {
+			return p, true
+		}
+	}
+	return Point{}, false
+}
+
+// release classifies a lifted finger.
+func (r *Recognizer) release() Kind {
+	d := r.end.Sub(r.start)
+	switch {
+	case r.longDone:
+		return None
+	case !r.moved:
+		return Tap
+	case abs(d.X) >= abs(d.Y) && abs(d.X) >= r.swipe():
+		if d.X < 0 {
+			return SwipeLeft
+		}
+		return SwipeRight
+	case abs(d.Y) > abs(d.X) && abs(d.Y) >= r.swipe():
+		if d.Y < 0 {
+			return SwipeUp
+		}
+		return SwipeDown
+	}
+	return None
+}
+
+func (r *Recognizer) gesture(k Kind, now time.Time) Gesture {
+	if k == None {
+		return Gesture{}
+	}
+	return Gesture{Kind: k, Start: r.start, End: r.end, Duration: now.Sub(r.t0)}
+}
+
+func (r *Recognizer) longPress() time.Duration {
+	if r.LongPress > 0 {
+		return r.LongPress
+	}
+	return DefaultLongPress
+}
+
+func (r *Recognizer) slop() int {
+	if r.Slop > 0 {
+		return r.Slop
+	}
+	return DefaultSlop
+}
+
+func (r *Recognizer) swipe() int {
+	if r.Swipe > 0 {
+		return r.Swipe
+	}
+	return DefaultSwipe
+}
+
+// dist is the larger axis distance between a and b.
+func dist(a, b image.Point) int {
+	d := b.Sub(a)
+	return max(abs(d.X), abs(d.Y))
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
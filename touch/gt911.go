@@ -0,0 +1,207 @@
+// Package touch reads the GT911 capacitive touch controller of the
+// optional T5 4.7" touch overlay and recognizes taps, long presses and
+// swipes.
+//
+// The controller is reached through the small I2C interface, which
+// TinyGo's *machine.I2C satisfies, so the package builds and is tested
+// without TinyGo. Points are reported in display coordinates: the raw
+// controller axes are swapped and mirrored onto the panel as configured
+// and then turned back through the display rotation, matching what
+// epd47.Rotation and widget.Screen draw.
+//
+// Resetting the controller and selecting its address through the INT pin
+// are left to the board; New probes both addresses.
+package touch
+
+import (
+	"errors"
+	"image"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+)
+
+// I2C is the bus the controller is read over. Tx writes w to the device at
+// addr and then reads len(r) bytes into r.
+type I2C interface {
+	Tx(addr uint16, w, r []byte) error
+}
+
+// GT911 addresses, selected by the INT level during reset.
+const (
+	Address    = 0x5D
+	AddressAlt = 0x14
+)
+
+// MaxPoints is the number of simultaneous touches the GT911 reports.
+const MaxPoints = 5
+
+// GT911 registers, 16-bit big-endian addresses.
+const (
+	regXMax      = 0x8048 // x and y resolution, uint16 little-endian each
+	regProductID = 0x8140 // "911" and a NUL
+	regStatus    = 0x814E // bit 7 buffer ready, bits 0-3 point count
+	regPoints    = 0x814F // MaxPoints records of pointLen bytes
+
+	pointLen    = 8 // track id, x, y, size (uint16 little-endian), reserved
+	statusReady = 0x80
+)
+
+var (
+	// ErrNotFound is returned by New when no GT911 answers.
+	ErrNotFound = errors.New("touch: GT911 not found")
+	// ErrResolution is returned by New when no panel size is configured
+	// and the controller reports none.
+	ErrResolution = errors.New("touch: unknown touch resolution")
+)
+
+// Config describes how the overlay is mounted.
+type Config struct {
+	// Address is the controller address; 0 probes Address and AddressAlt.
+	Address uint16
+	// Width and Height are the panel size in pixels; 0 reads the
+	// resolution from the controller configuration.
+	Width, Height int
+	// SwapXY exchanges the raw axes, then MirrorX and MirrorY flip them,
+	// to bring raw coordinates onto the panel.
+	SwapXY, MirrorX, MirrorY bool
+	// Rotation is the display rotation points are reported in.
+	Rotation epd47.Rotation
+}
+
+// Point is one touch in display coordinates.
+type Point struct {
+	ID   uint8 // track id, stable while the finger stays down
+	Pos  image.Point
+	Size int // contact area, in controller units
+}
+
+// GT911 is a touch controller on an I2C bus.
+type GT911 struct {
+	bus  I2C
+	addr uint16
+	cfg  Config
+	w, h int // panel size
+
+	points [MaxPoints]Point
+	n      int
+
+	reg [3]byte
+	buf [MaxPoints * pointLen]byte
+}
+
+// New finds the controller on bus and prepares it for Read.
+func New(bus I2C, cfg Config) (*GT911, error) {
+	g := &GT911{bus: bus, cfg: cfg}
+	addrs := []uint16{Address, AddressAlt}
+	if cfg.Address != 0 {
+		addrs = []uint16{cfg.Address}
+	}
+	for _, a := range addrs {
+		g.addr = a
+		if id, err := g.read(regProductID, 4); err == nil && string(id[:3]) == "911" {
+			return g, g.setup()
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// setup takes the panel size from the config or the controller.
+func (g *GT911) setup() error {
+	g.w, g.h = g.cfg.Width, g.cfg.Height
+	if g.w <= 0 || g.h <= 0 {
+		res, err := g.read(regXMax, 4)
+		if err != nil {
+			return err
+		}
+		g.w = int(res[0]) | int(res[1])<<8
+		g.h = int(res[2]) | int(res[3])<<8
+		if g.cfg.SwapXY {
+			g.w, g.h = g.h, g.w
+		}
+	}
+	if g.w <= 0 || g.h <= 0 {
+		return ErrResolution
+	}
+	return nil
+}
+
+// Rotation returns the display rotation points are reported in.
+func (g *GT911) Rotation() epd47.Rotation { return g.cfg.Rotation }
+
+// SetRotation changes the display rotation, as after widget.Screen's
+// SetRotation.
+func (g *GT911) SetRotation(r epd47.Rotation) { g.cfg.Rotation = r }
+
+// Size returns the display size points are reported in.
+func (g *GT911) Size() (int, int) { return g.cfg.Rotation.Size(g.w, g.h) }
+
+// Read returns the current touches. When the controller has no new data
+// the previous touches are returned again. The slice is reused by the next
+// Read.
+func (g *GT911) Read() ([]Point, error) {
+	st, err := g.read(regStatus, 1)
+	if err != nil {
+		return nil, err
+	}
+	if st[0]&statusReady == 0 {
+		return g.points[:g.n], nil
+	}
+	n := int(st[0] & 0x0F)
+	if n > MaxPoints {
+		n = 0 // invalid frame: report no touch
+	}
+	if n > 0 {
+		raw, err := g.read(regPoints, n*pointLen)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < n; i++ {
+			r := raw[i*pointLen:]
+			g.points[i] = Point{
+				ID:   r[0],
+				Pos:  g.mapPoint(int(r[1])|int(r[2])<<8, int(r[3])|int(r[4])<<8),
+				Size: int(r[5]) | int(r[6])<<8,
+			}
+		}
+	}
+	g.n = n
+	// Acknowledge, or the controller stops updating the buffer.
+	if err := g.write(regStatus, 0); err != nil {
+		return nil, err
+	}
+	return g.points[:g.n], nil
+}
+
+// mapPoint brings raw controller coordinates onto the panel and then into
+// display coordinates.
+func (g *GT911) mapPoint(x, y int) image.Point {
+	if g.cfg.SwapXY {
+		x, y = y, x
+	}
+	x = max(0, min(x, g.w-1))
+	y = max(0, min(y, g.h-1))
+	if g.cfg.MirrorX {
+		x = g.w - 1 - x
+	}
+	if g.cfg.MirrorY {
+		y = g.h - 1 - y
+	}
+	lw, lh := g.Size()
+	return g.cfg.Rotation.Inverse().Point(image.Pt(x, y), lw, lh)
+}
+
+// read reads n bytes from register reg into the shared buffer.
+func (g *GT911) read(reg uint16, n int) ([]byte, error) {
+	g.reg[0], g.reg[1] = byte(reg>>8), byte(reg)
+	b := g.buf[:n]
+	if err := g.bus.Tx(g.addr, g.reg[:2], b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// write writes one byte to register reg.
+func (g *GT911) write(reg uint16, v byte) error {
+	g.reg[0], g.reg[1], g.reg[2] = byte(reg>>8), byte(reg), v
+	return g.bus.Tx(g.addr, g.reg[:3], nil)
+}
@@ -0,0 +1,188 @@
+package touch
+
+import (
+	"errors"
+	"image"
+	"testing"
+	"time"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+)
+
+// fakeBus is a GT911 register file at one address.
+type fakeBus struct {
+	addr uint16
+	regs map[uint16]byte
+	acks int // writes of 0 to the status register
+}
+
+func newFakeBus(addr uint16) *fakeBus {
+	f := &fakeBus{addr: addr, regs: map[uint16]byte{}}
+	f.set(regProductID, '9', '1', '1', 0)
+	f.set(regXMax, 960&0xFF, 960>>8, 540&0xFF, 540>>8)
+	return f
+}
+
+func (f *fakeBus) set(reg uint16, b ...byte) {
+	for i, v := range b {
+		f.regs[reg+uint16(i)] = v
+	}
+}
+
+// touch loads raw points as (id, x, y) triples and marks the buffer ready.
+func (f *fakeBus) touch(pts ...[3]int) {
+	f.set(regStatus, statusReady|byte(len(pts)))
+	for i, p := range pts {
+		f.set(regPoints+uint16(i*pointLen), byte(p[0]), byte(p[1]), byte(p[1]>>8), byte(p[2]), byte(p[2]>>8), 30, 0, 0)
+	}
+}
+
+func (f *fakeBus) Tx(addr uint16, w, r []byte) error {
+	if addr != f.addr {
+		return errors.New("nack")
+	}
+	reg := uint16(w[0])<<8 | uint16(w[1])
+	if len(w) > 2 {
+		f.set(reg, w[2:]...)
+		if reg == regStatus && w[2] == 0 {
+			f.acks++
+		}
+	}
+	for i := range r {
+		r[i] = f.regs[reg+uint16(i)]
+	}
+	return nil
+}
+
+func TestProbe(t *testing.T) {
+	g, err := New(newFakeBus(AddressAlt), Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.addr != AddressAlt {
+		t.Errorf("Expected the alternate address, got %#x", g.addr)
+	}
+	if w, h := g.Size(); w != 960 || h != 540 {
+		t.Errorf("Expected the resolution read from the controller, got %dx%d", w, h)
+	}
+	if _, err := New(newFakeBus(0x33), Config{}); err != ErrNotFound {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	bus := newFakeBus(Address)
+	bus.set(regXMax, 0, 0, 0, 0)
+	if _, err := New(bus, Config{}); err != ErrResolution {
+		t.Errorf("Expected ErrResolution, got %v", err)
+	}
+}
+
+func TestReadPoints(t *testing.T) {
+	bus := newFakeBus(Address)
+	g, err := New(bus, Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bus.touch([3]int{1, 100, 50}, [3]int{2, 900, 500})
+	pts, err := g.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pts) != 2 || pts[0].Pos != image.Pt(100, 50) || pts[1].Pos != image.Pt(900, 500) || pts[1].ID != 2 || pts[0].Size != 30 {
+		t.Errorf("Expected two points, got %v", pts)
+	}
+	if bus.acks != 1 || bus.regs[regStatus] != 0 {
+		t.Error("Expected the buffer acknowledged")
+	}
+
+	// No new data repeats the last touches.
+	if pts, _ = g.Read(); len(pts) != 2 {
+		t.Errorf("Expected the previous points, got %v", pts)
+	}
+	bus.touch()
+	if pts, _ = g.Read(); len(pts) != 0 {
+		t.Errorf("Expected the touches released, got %v", pts)
+	}
+	bus.set(regStatus, statusReady|9)
+	if pts, _ = g.Read(); len(pts) != 0 {
+		t.Errorf("Expected an invalid count ignored, got %v", pts)
+	}
+}
+
+func TestRotation(t *testing.T) {
+	bus := newFakeBus(Address)
+	g, err := New(bus, Config{Rotation: epd47.Rotate90})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w, h := g.Size(); w != 540 || h != 960 {
+		t.Errorf("Expected a portrait size, got %dx%d", w, h)
+	}
+	bus.touch([3]int{1, 100, 50})
+	pts, _ := g.Read()
+	want := image.Pt(50, 859)
+	if pts[0].Pos != want {
+		t.Errorf("Expected %v, got %v", want, pts[0].Pos)
+	}
+	// Drawing at the reported point lands where the finger is.
+	if p := epd47.Rotate90.Point(pts[0].Pos, 960, 540); p != image.Pt(100, 50) {
+		t.Errorf("Expected the point to map back to the panel, got %v", p)
+	}
+
+	// A mounted-sideways overlay: raw axes swapped and y mirrored.
+	bus = newFakeBus(Address)
+	g, _ = New(bus, Config{Width: 960, Height: 540, SwapXY: true, MirrorY: true})
+	bus.touch([3]int{1, 50, 100})
+	if pts, _ = g.Read(); pts[0].Pos != image.Pt(100, 489) {
+		t.Errorf("Expected (100,489), got %v", pts[0].Pos)
+	}
+}
+
+func TestGestures(t *testing.T) {
+	t0 := time.Unix(0, 0)
+	at := func(ms int) time.Time { return t0.Add(time.Duration(ms) * time.Millisecond) }
+	pt := func(id uint8, x, y int) []Point { return []Point{{ID: id, Pos: image.Pt(x, y)}} }
+
+	var r Recognizer
+	r.Update(pt(1, 100, 100), at(0))
+	r.Update(pt(1, 105, 102), at(100))
+	if g := r.Update(nil, at(150)); g.Kind != Tap || g.Start != image.Pt(100, 100) || g.Duration != 150*time.Millisecond {
+		t.Errorf("Expected a tap, got %+v", g)
+	}
+
+	r.Update(pt(1, 100, 100), at(1000))
+	if g := r.Update(pt(1, 101, 100), at(1500)); g.Kind != None {
+		t.Errorf("Expected nothing before the hold time, got %+v", g)
+	}
+	if g := r.Update(pt(1, 101, 100), at(1650)); g.Kind != LongPress {
+		t.Errorf("Expected a long press while held, got %+v", g)
+	}
+	if g := r.Update(pt(1, 101, 100), at(1700)); g.Kind != None {
+		t.Errorf("Expected the long press reported once, got %+v", g)
+	}
+	if g := r.Update(nil, at(1800)); g.Kind != None {
+		t.Errorf("Expected no tap after a long press, got %+v", g)
+	}
+
+	for _, c := range []struct {
+		to   image.Point
+		want Kind
+	}{
+		{image.Pt(300, 120), SwipeRight},
+		{image.Pt(-50, 80), SwipeLeft},
+		{image.Pt(90, -100), SwipeUp},
+		{image.Pt(130, 400), SwipeDown},
+		{image.Pt(150, 150), None}, // moved, but not far enough
+	} {
+		r.Update(pt(3, 100, 100), at(2000))
+		r.Update(pt(3, c.to.X, c.to.Y), at(2200))
+		if g := r.Update(nil, at(2250)); g.Kind != c.want || (c.want != None && g.End != c.to) {
+			t.Errorf("Expected %v to %v, got %+v", c.want, c.to, g)
+		}
+	}
+
+	// A second finger does not disturb the first.
+	r.Update(pt(4, 100, 100), at(3000))
+	r.Update([]Point{{ID: 5, Pos: image.Pt(600, 300)}, {ID: 4, Pos: image.Pt(101, 100)}}, at(3050))
+	if g := r.Update(pt(5, 600, 300), at(3100)); g.Kind != Tap || g.End != image.Pt(101, 100) {
+		t.Errorf("Expected the first finger's tap, got %+v", g)
+	}
+}
@@ -0,0 +1,227 @@
+package widget
+
+import (
+	"image"
+	"math"
+	"strconv"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+	"github.com/abaschen/tinygo-epd47-s3/font"
+)
+
+// clamp01 limits v to 0..1, mapping NaN to 0.
+func clamp01(v float32) float32 {
+	if !(v > 0) {
+		return 0
+	}
+	return min(v, 1)
+}
+
+// ProgressBar fills left to right with its value from 0 to 1.
+type ProgressBar struct {
+	Base
+	Border    int   // outline width in pixels, 0 for none
+	BorderInk uint8 // outline level
+	Ink       uint8 // filled part
+	Track     uint8 // unfilled part
+
+	value float32
+}
+
+// NewProgressBar returns an empty bar with a 1px black outline and a
+// black fill.
+func NewProgressBar() *ProgressBar {
+	return &ProgressBar{Border: 1, BorderInk: 15, Ink: 15}
+}
+
+// Value returns the progress from 0 to 1.
+func (p *ProgressBar) Value() float32 { return p.value }
+
+// SetValue sets the progress, clamped to 0..1.
+func (p *ProgressBar) SetValue(v float32) {
+	if v = clamp01(v); v != p.value {
+		p.value = v
+		p.dirty = true
+	}
+}
+
+// Draw implements Widget.
+func (p *ProgressBar) Draw(dst *epd47.Bitmap4bpp, r image.Rectangle) {
+	dst.StrokeRect(r, p.Border, p.BorderInk)
+	in := r.Inset(p.Border)
+	if p.Border > 0 {
+		in = in.Inset(1) // leave a gap between outline and fill
+	}
+	split := in.Min.X + int(float32(in.Dx())*p.value+0.5)
+	dst.FillRect(image.Rect(in.Min.X, in.Min.Y, split, in.Max.Y), p.Ink)
+	dst.FillRect(image.Rect(split, in.Min.Y, in.Max.X, in.Max.Y), p.Track)
+}
+
+// Gauge is a radial gauge: a 270° ring opening at the bottom that fills
+// clockwise from Min to Max, with the value printed in the middle.
+type Gauge struct {
+	Base
+	Min, Max  float32
+	Precision int    // digits after the decimal point
+	Unit      string // appended to the value
+	Font      *font.Font
+	Thickness int   // ring width in pixels, 0 for a fifth of the radius
+	Ink       uint8 // filled arc and text
+	Track     uint8 // unfilled arc
+
+	value float32
+}
+
+// NewGauge returns a gauge from min to max with a light gray track.
+func NewGauge(min, max float32) *Gauge {
+	return &Gauge{Min: min, Max: max, Ink: 15, Track: 3}
+}
+
+// Value returns the displayed value.
+func (g *Gauge) Value() float32 { return g.value }
+
+// SetValue changes the value; values outside Min..Max pin the arc at the
+// end but are printed as is.
+func (g *Gauge) SetValue(v float32) {
+	if v != g.value {
+		g.value = v
+		g.dirty = true
+	}
+}
+
+// Draw implements Widget.
+func (g *Gauge) Draw(dst *epd47.Bitmap4bpp, r image.Rectangle) {
+	size := min(r.Dx(), r.Dy())
+	if size <= 0 {
+		return
+	}
+	outer := float64(size) / 2
+	t := g.Thickness
+	if t <= 0 {
+		t = max(1, size/10)
+	}
+	inner := outer - float64(t)
+	cx := float64(r.Min.X) + float64(r.Dx())/2
+	cy := float64(r.Min.Y) + float64(r.Dy())/2
+
+	var frac float32
+	if g.Max != g.Min {
+		frac = clamp01((g.value - g.Min) / (g.Max - g.Min))
+	}
+	end := 270 * float64(frac)
+
+	x0, y0 := int(cx-outer), int(cy-outer)
+	for y := y0; y < y0+size; y++ {
+		dy := float64(y) + 0.5 - cy
+		for x := x0; x < x0+size; x++ {
+			dx := float64(x) + 0.5 - cx
+			d2 := dx*dx + dy*dy
+			if d2 > outer*outer || d2 < inner*inner {
+				continue
+			}
+			// Degrees clockwise from the start at the bottom left; y grows
+			// downwards, so Atan2 already turns clockwise.
+			a := math.Atan2(dy, dx)*180/math.Pi - 135
+			if a < 0 {
+				a += 360
+			}
+			switch {
+			case a > 270:
+			case frac > 0 && a <= end:
+				dst.SetLevel(x, y, g.Ink)
+			default:
+				dst.SetLevel(x, y, g.Track)
+			}
+		}
+	}
+
+	s := strconv.FormatFloat(float64(g.value), 'f', g.Precision, 32) + g.Unit
+	in := int(inner * 0.7)
+	box := image.Rect(int(cx)-in, int(cy)-in, int(cx)+in, int(cy)+in)
+	drawText(dst, box, s, g.Font, font.AlignCenter, g.Ink)
+}
+
+// Battery is a battery icon with the charge shown as a horizontal fill and
+// the terminal on the right.
+type Battery struct {
+	Base
+	Ink uint8
+	// Low is the percentage at or below which the outline is drawn twice
+	// as thick as a warning; 0 disables it.
+	Low int
+
+	percent int
+}
+
+// NewBattery returns an empty black battery icon.
+func NewBattery() *Battery {
+	return &Battery{Ink: 15}
+}
+
+// Percent returns the displayed charge.
+func (b *Battery) Percent() int { return b.percent }
+
+// SetPercent sets the charge, clamped to 0..100.
+func (b *Battery) SetPercent(p int) {
+	p = max(0, min(p, 100))
+	if p != b.percent {
+		b.percent = p
+		b.dirty = true
+	}
+}
+
+// Draw implements Widget.
+func (b *Battery) Draw(dst *epd47.Bitmap4bpp, r image.Rectangle) {
+	nub := max(2, r.Dx()/12)
+	body := image.Rect(r.Min.X, r.Min.Y, r.Max.X-nub, r.Max.Y)
+	t := max(1, r.Dy()/10)
+	if b.Low > 0 && b.percent <= b.Low {
+		t *= 2
+	}
+	dst.StrokeRect(body, t, b.Ink)
+	q := r.Dy() / 4
+	dst.FillRect(image.Rect(body.Max.X, r.Min.Y+q, r.Max.X, r.Max.Y-q), b.Ink)
+
+	in := body.Inset(2 * t)
+	w := (in.Dx()*b.percent + 50) / 100
+	dst.FillRect(image.Rect(in.Min.X, in.Min.Y, in.Min.X+w, in.Max.Y), b.Ink)
+}
+
+// BatterySource measures a battery, such as *epd47.BatteryMonitor:
+// Sample takes a reading and Percent reports the charge from it.
+type BatterySource interface {
+	Sample() float32
+	Percent() int
+}
+
+// BatteryLevel is a Battery icon with the charge printed after it, fed
+// from a battery monitor.
+type BatteryLevel struct {
+	Battery
+	Font   *font.Font // nil selects epd47.DefaultFont
+	Source BatterySource
+}
+
+// NewBatteryLevel returns a black battery level reading src.
+func NewBatteryLevel(src BatterySource) *BatteryLevel {
+	return &BatteryLevel{Battery: Battery{Ink: 15}, Source: src}
+}
+
+// Refresh samples the source; the widget is dirtied only when the
+// percentage changes.
+func (b *BatteryLevel) Refresh() {
+	if b.Source != nil {
+		b.Source.Sample()
+		b.SetPercent(b.Source.Percent())
+	}
+}
+
+// Draw implements Widget. The icon is twice as wide as it is high and the
+// text fills the rest of r.
+func (b *BatteryLevel) Draw(dst *epd47.Bitmap4bpp, r image.Rectangle) {
+	icon := r
+	icon.Max.X = min(r.Max.X, r.Min.X+2*r.Dy())
+	b.Battery.Draw(dst, icon)
+	text := image.Rect(icon.Max.X+r.Dy()/4, r.Min.Y, r.Max.X, r.Max.Y)
+	drawText(dst, text, strconv.Itoa(b.percent)+"%", b.Font, font.AlignLeft, b.Ink)
+}
@@ -0,0 +1,105 @@
+package widget
+
+import (
+	"image"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+	"github.com/abaschen/tinygo-epd47-s3/font"
+)
+
+// Label shows text wrapped to its width and centered vertically.
+type Label struct {
+	Base
+	Font  *font.Font // nil selects epd47.DefaultFont
+	Align font.Align
+	Ink   uint8
+
+	text string
+}
+
+// NewLabel returns a black, left-aligned label.
+func NewLabel(text string, f *font.Font) *Label {
+	return &Label{Font: f, Ink: 15, text: text}
+}
+
+// Text returns the label's text.
+func (l *Label) Text() string { return l.text }
+
+// SetText changes the text; setting the same text does not dirty the label.
+func (l *Label) SetText(s string) {
+	if s != l.text {
+		l.text = s
+		l.dirty = true
+	}
+}
+
+// Draw implements Widget.
+func (l *Label) Draw(dst *epd47.Bitmap4bpp, r image.Rectangle) {
+	drawText(dst, r, l.text, l.Font, l.Align, l.Ink)
+}
+
+// drawText renders s wrapped to r and centered vertically in it.
+func drawText(dst *epd47.Bitmap4bpp, r image.Rectangle, s string, f *font.Font, align font.Align, ink uint8) {
+	if s == "" {
+		return
+	}
+	if f == nil {
+		f = epd47.DefaultFont
+	}
+	if _, h := f.Measure(s, r.Dx()); h < r.Dy() {
+		r.Min.Y += (r.Dy() - h) / 2
+	}
+	font.DrawBox(dst, f, r, s, align, ink)
+}
+
+// ValueBox is a bordered box with a small caption in the top-left corner
+// and a large value centered below it, such as a sensor reading.
+type ValueBox struct {
+	Base
+	Caption     string
+	Unit        string     // appended to the value after a space
+	CaptionFont *font.Font // nil selects font.DejaVuSans16
+	ValueFont   *font.Font // nil selects font.DejaVuSans24
+	Border      int        // outline width in pixels, 0 for none
+	Ink         uint8
+
+	value string
+}
+
+// NewValueBox returns a value box with a 2px black border.
+func NewValueBox(caption, unit string) *ValueBox {
+	return &ValueBox{Caption: caption, Unit: unit, Border: 2, Ink: 15}
+}
+
+// Value returns the displayed value.
+func (v *ValueBox) Value() string { return v.value }
+
+// SetValue changes the value; setting the same value does not dirty the box.
+func (v *ValueBox) SetValue(s string) {
+	if s != v.value {
+		v.value = s
+		v.dirty = true
+	}
+}
+
+// Draw implements Widget.
+func (v *ValueBox) Draw(dst *epd47.Bitmap4bpp, r image.Rectangle) {
+	dst.StrokeRect(r, v.Border, v.Ink)
+	in := r.Inset(v.Border + 4)
+	cf, vf := v.CaptionFont, v.ValueFont
+	if cf == nil {
+		cf = font.DejaVuSans16
+	}
+	if vf == nil {
+		vf = font.DejaVuSans24
+	}
+	if v.Caption != "" {
+		font.DrawBox(dst, cf, in, v.Caption, font.AlignLeft, v.Ink)
+		in.Min.Y += cf.LineHeight
+	}
+	s := v.value
+	if v.Unit != "" {
+		s += " " + v.Unit
+	}
+	drawText(dst, in, s, vf, font.AlignCenter, v.Ink)
+}
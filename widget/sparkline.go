@@ -0,0 +1,166 @@
+package widget
+
+import (
+	"image"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+)
+
+// Sparkline plots the most recent values of a series as a 1px line,
+// scaled to fit its height. New values enter on the right and the spacing
+// between points is fixed by the capacity, so the line scrolls as it fills.
+type Sparkline struct {
+	Base
+	// Min and Max fix the vertical scale; when equal the scale follows the
+	// values on screen.
+	Min, Max float32
+	Ink      uint8
+	Fill     uint8 // level under the line, 0 for none
+
+	vals  []float32 // ring buffer
+	start int
+	n     int
+}
+
+// NewSparkline returns a sparkline keeping the last capacity values.
+func NewSparkline(capacity int) *Sparkline {
+	return &Sparkline{Ink: 15, vals: make([]float32, max(capacity, 2))}
+}
+
+// Push appends v, dropping the oldest value when full.
+func (s *Sparkline) Push(v float32) {
+	if s.n < len(s.vals) {
+		s.vals[(s.start+s.n)%len(s.vals)] = v
+		s.n++
+	} else {
+		s.vals[s.start] = v
+		s.start = (s.start + 1) % len(s.vals)
+	}
+	s.dirty = true
+}
+
+// Len returns the number of values held.
+func (s *Sparkline) Len() int { return s.n }
+
+// At returns the i-th value held, 0 being the oldest.
+func (s *Sparkline) At(i int) float32 { return s.vals[(s.start+i)%len(s.vals)] }
+
+// Reset drops all values.
+func (s *Sparkline) Reset() {
+	if s.n > 0 {
+		s.start, s.n = 0, 0
+		s.dirty = true
+	}
+}
+
+// Draw implements Widget.
+func (s *Sparkline) Draw(dst *epd47.Bitmap4bpp, r image.Rectangle) {
+	if s.n == 0 || r.Empty() {
+		return
+	}
+	lo, hi := s.Min, s.Max
+	if lo == hi {
+		lo, hi = s.At(0), s.At(0)
+		for i := 1; i < s.n; i++ {
+			lo, hi = min(lo, s.At(i)), max(hi, s.At(i))
+		}
+	}
+	h := r.Dy() - 1
+	py := func(v float32) int {
+		if hi == lo {
+			return r.Min.Y + h/2
+		}
+		f := (min(max(v, lo), hi) - lo) / (hi - lo)
+		return r.Max.Y - 1 - int(f*float32(h)+0.5)
+	}
+	step := float32(r.Dx()-1) / float32(len(s.vals)-1)
+	px := func(i int) int {
+		return r.Max.X - 1 - int(float32(s.n-1-i)*step+0.5)
+	}
+
+	x0, y0 := px(0), py(s.At(0))
+	if s.Fill != 0 {
+		dst.VLine(x0, y0, r.Max.Y-1, s.Fill)
+	}
+	for i := 1; i < s.n; i++ {
+		x1, y1 := px(i), py(s.At(i))
+		if s.Fill != 0 {
+			for x := x0 + 1; x <= x1; x++ {
+				y := y0 + (y1-y0)*(x-x0)/(x1-x0)
+				dst.VLine(x, y, r.Max.Y-1, s.Fill)
+			}
+		}
+		dst.Line(x0, y0, x1, y1, s.Ink)
+		x0, y0 = x1, y1
+	}
+	if s.n == 1 {
+		dst.SetLevel(x0, y0, s.Ink)
+	}
+}
+
+// BarChart draws one vertical bar per value, rising from the bottom.
+type BarChart struct {
+	Base
+	// Max is the value of a full-height bar; 0 scales to the largest value.
+	Max float32
+	Gap int // pixels between bars
+	Ink uint8
+
+	vals []float32
+}
+
+// NewBarChart returns an empty chart with black bars 2px apart.
+func NewBarChart() *BarChart {
+	return &BarChart{Gap: 2, Ink: 15}
+}
+
+// Values returns the plotted values. The slice is the chart's own.
+func (c *BarChart) Values() []float32 { return c.vals }
+
+// SetValues copies vs into the chart; equal values do not dirty it.
+func (c *BarChart) SetValues(vs []float32) {
+	if len(vs) == len(c.vals) {
+		same := true
+		for i, v := range vs {
+			if v != c.vals[i] {
+				same = false
+				break
+			}
+		}
+		if same {
+			return
+		}
+	}
+	c.vals = append(c.vals[:0], vs...)
+	c.dirty = true
+}
+
+// Draw implements Widget. Negative values draw no bar; bars that do not
+// fit in the width are dropped.
+func (c *BarChart) Draw(dst *epd47.Bitmap4bpp, r image.Rectangle) {
+	n := len(c.vals)
+	if n == 0 {
+		return
+	}
+	top := c.Max
+	if top <= 0 {
+		for _, v := range c.vals {
+			top = max(top, v)
+		}
+	}
+	if top <= 0 {
+		return
+	}
+	w := (r.Dx() - c.Gap*(n-1)) / n
+	if w <= 0 {
+		w = 1
+	}
+	for i, v := range c.vals {
+		x := r.Min.X + i*(w+c.Gap)
+		if x >= r.Max.X {
+			break // more bars than pixels
+		}
+		h := int(clamp01(v/top)*float32(r.Dy()) + 0.5)
+		dst.FillRect(image.Rect(x, r.Max.Y-h, x+w, r.Max.Y).Intersect(r), c.Ink)
+	}
+}
@@ -0,0 +1,294 @@
+// Package widget is a retained-mode UI toolkit for status displays on the
+// epd47 panel: labels, value boxes, progress bars, radial gauges, battery
+// icons, sparklines and bar charts.
+//
+// Widgets keep their own state and mark themselves dirty when a setter
+// changes what they show. Screen.Update then repaints only the dirty areas,
+// each as one partial update: the area is cleared with ClearArea and every
+// widget overlapping it is drawn into a single 4bpp bitmap, so unchanged
+// parts of the panel never flash. Overlapping dirty areas are merged into
+// one update.
+//
+// A Screen can be rotated for portrait use; widgets are then placed in the
+// rotated coordinates and a layout attached with SetLayout is re-run
+// whenever the rotation changes.
+//
+// Exported style fields (fonts, inks, borders) may be changed at any time;
+// call Invalidate afterwards to repaint the widget.
+package widget
+
+import (
+	"image"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+)
+
+// Widget is an element of a Screen. Draw renders the widget into dst with
+// its bounds at r; r may extend past dst, which clips. A Screen also clips
+// dst to r, so ink outside the bounds never reaches a neighbour. Custom
+// widgets embed Base to satisfy the interface.
+type Widget interface {
+	Draw(dst *epd47.Bitmap4bpp, r image.Rectangle)
+	base() *Base
+}
+
+// Base holds the placement and dirty state shared by all widgets.
+type Base struct {
+	bounds image.Rectangle
+	drawn  image.Rectangle // area painted by the last update
+	dirty  bool
+	hidden bool
+}
+
+func (b *Base) base() *Base { return b }
+
+// Bounds returns the widget's area in panel coordinates.
+func (b *Base) Bounds() image.Rectangle { return b.bounds }
+
+// SetBounds moves or resizes the widget; the old area is repainted too.
+func (b *Base) SetBounds(r image.Rectangle) {
+	if r != b.bounds {
+		b.bounds = r
+		b.dirty = true
+	}
+}
+
+// Hidden reports whether the widget is hidden.
+func (b *Base) Hidden() bool { return b.hidden }
+
+// SetHidden hides or shows the widget; hiding repaints its area without it.
+func (b *Base) SetHidden(hidden bool) {
+	if hidden != b.hidden {
+		b.hidden = hidden
+		b.dirty = true
+	}
+}
+
+// Invalidate marks the widget for repainting on the next update.
+func (b *Base) Invalidate() { b.dirty = true }
+
+// Dirty reports whether the widget needs repainting.
+func (b *Base) Dirty() bool { return b.dirty }
+
+// visible returns the area the widget should occupy after the next update.
+func (b *Base) visible() image.Rectangle {
+	if b.hidden {
+		return image.Rectangle{}
+	}
+	return b.bounds
+}
+
+// Display is the part of *epd47.Device a Screen draws through. A draw
+// returning an error, such as epd47.ErrLowPower from a power guard, is
+// retried by the next Update.
+type Display interface {
+	Bounds() image.Rectangle
+	ClearArea(r image.Rectangle, cycles int)
+	DrawRows4bpp(x, y, w, h int, src epd47.RowSource, mode epd47.DrawMode) error
+}
+
+// Layout places widgets inside the screen bounds; see package layout.
+type Layout interface {
+	SetBounds(r image.Rectangle)
+}
+
+// Screen owns a set of widgets on a display and repaints the dirty ones.
+type Screen struct {
+	// Background is the level behind the widgets, 0 (white) by default.
+	Background uint8
+	// ClearCycles is passed to ClearArea before each partial update;
+	// 0 skips clearing, which only suits areas drawn for the first time.
+	ClearCycles int
+
+	disp    Display
+	rot     epd47.Rotation
+	root    Layout
+	widgets []Widget
+	damage  []image.Rectangle // areas of removed widgets, logical
+	full    bool
+	clip    []byte // pixels of the bitmap a widget is clipped to
+}
+
+// NewScreen returns an empty screen drawing to d with one clear cycle per
+// update.
+func NewScreen(d Display) *Screen {
+	return &Screen{disp: d, ClearCycles: 1}
+}
+
+// Add appends widgets on top of the existing ones and marks them dirty.
+func (s *Screen) Add(ws ...Widget) {
+	for _, w := range ws {
+		w.base().dirty = true
+		s.widgets = append(s.widgets, w)
+	}
+}
+
+// Remove takes w off the screen, repainting its area on the next update,
+// and reports whether it was there.
+func (s *Screen) Remove(w Widget) bool {
+	for i, v := range s.widgets {
+		if v == w {
+			s.widgets = append(s.widgets[:i], s.widgets[i+1:]...)
+			s.damage = append(s.damage, w.base().drawn)
+			w.base().drawn = image.Rectangle{}
+			return true
+		}
+	}
+	return false
+}
+
+// Widgets returns the widgets, bottom first. The slice is the screen's own.
+func (s *Screen) Widgets() []Widget { return s.widgets }
+
+// Bounds returns the screen area in the rotated coordinates widgets use.
+func (s *Screen) Bounds() image.Rectangle {
+	db := s.disp.Bounds()
+	w, h := s.rot.Size(db.Dx(), db.Dy())
+	return image.Rect(0, 0, w, h)
+}
+
+// Rotation returns the screen's rotation.
+func (s *Screen) Rotation() epd47.Rotation { return s.rot }
+
+// SetRotation turns the screen, re-runs the layout and repaints everything
+// on the next update.
+func (s *Screen) SetRotation(r epd47.Rotation) {
+	r &= 3
+	if r != s.rot {
+		s.rot = r
+		s.full = true
+		s.relayout()
+	}
+}
+
+// SetLayout attaches l, placing it over the whole screen now and again
+// whenever the rotation changes. Nil detaches the layout.
+func (s *Screen) SetLayout(l Layout) {
+	s.root = l
+	s.relayout()
+}
+
+func (s *Screen) relayout() {
+	if s.root != nil {
+		s.root.SetBounds(s.Bounds())
+	}
+}
+
+// Invalidate repaints the whole display on the next update, for example
+// after ClearDisplay.
+func (s *Screen) Invalidate() { s.full = true }
+
+// Dirty returns the panel areas the next update will repaint: dirty widget
+// bounds and the areas they left, rotated onto the panel, clipped to it
+// and merged where they overlap.
+func (s *Screen) Dirty() []image.Rectangle {
+	db := s.disp.Bounds()
+	if s.full {
+		return []image.Rectangle{db}
+	}
+	var rs []image.Rectangle
+	add := func(r image.Rectangle) {
+		r = s.rot.Rect(r.Intersect(s.Bounds()), db.Dx(), db.Dy()).Intersect(db)
+		if r.Empty() {
+			return
+		}
+		rs = append(rs, r)
+	}
+	for _, r := range s.damage {
+		add(r)
+	}
+	for _, w := range s.widgets {
+		b := w.base()
+		if !b.dirty {
+			continue
+		}
+		if v := b.visible(); b.drawn != v {
+			add(b.drawn)
+		}
+		add(b.visible())
+	}
+	return merge(rs)
+}
+
+// merge unions overlapping rectangles until none overlap.
+func merge(rs []image.Rectangle) []image.Rectangle {
+	for merged := true; merged; {
+		merged = false
+		for i := 0; i < len(rs); i++ {
+			for j := i + 1; j < len(rs); j++ {
+				if rs[i].Overlaps(rs[j]) {
+					rs[i] = rs[i].Union(rs[j])
+					rs = append(rs[:j], rs[j+1:]...)
+					merged = true
+					j--
+				}
+			}
+		}
+	}
+	return rs
+}
+
+// Update repaints the dirty areas, one partial update each, and returns
+// how many updates it made. If a draw fails, for example when a power
+// guard defers it, Update stops and returns the error; every widget stays
+// dirty, so the next Update repaints what was not drawn.
+func (s *Screen) Update() (int, error) {
+	rs := s.Dirty()
+	for i, r := range rs {
+		if err := s.render(r); err != nil {
+			return i, err
+		}
+	}
+	for _, w := range s.widgets {
+		b := w.base()
+		b.drawn = b.visible()
+		b.dirty = false
+	}
+	s.damage = s.damage[:0]
+	s.full = false
+	return len(rs), nil
+}
+
+// render draws every visible widget overlapping panel area r into one
+// bitmap and pushes it to the display.
+func (s *Screen) render(r image.Rectangle) error {
+	lb := s.Bounds()
+	lr := s.rot.Inverse().Rect(r, lb.Dx(), lb.Dy())
+	bm := epd47.NewBitmap4bpp(lr.Dx(), lr.Dy())
+	bm.Fill(s.Background)
+	for _, w := range s.widgets {
+		if v := w.base().visible(); v.Overlaps(lr) {
+			s.drawClipped(bm, w, v.Sub(lr.Min))
+		}
+	}
+	if s.rot != epd47.Rotate0 {
+		bm = bm.Rotate(s.rot)
+	}
+	if s.ClearCycles > 0 {
+		s.disp.ClearArea(r, s.ClearCycles)
+	}
+	return s.disp.DrawRows4bpp(r.Min.X, r.Min.Y, bm.Width, bm.Height, bm, epd47.BlackOnWhite)
+}
+
+// drawClipped draws w with its bounds at r into bm, keeping its ink inside
+// r: the widget draws into a copy of the covered part of bm, which is then
+// copied back.
+func (s *Screen) drawClipped(bm *epd47.Bitmap4bpp, w Widget, r image.Rectangle) {
+	c := r.Intersect(bm.Bounds())
+	stride := (c.Dx() + 1) / 2
+	if cap(s.clip) < stride*c.Dy() {
+		s.clip = make([]byte, stride*c.Dy())
+	}
+	t := &epd47.Bitmap4bpp{Width: c.Dx(), Height: c.Dy(), Stride: stride, Pix: s.clip[:stride*c.Dy()]}
+	for y := 0; y < t.Height; y++ {
+		for x := 0; x < t.Width; x++ {
+			t.SetLevel(x, y, bm.Level(c.Min.X+x, c.Min.Y+y))
+		}
+	}
+	w.Draw(t, r.Sub(c.Min))
+	for y := 0; y < t.Height; y++ {
+		for x := 0; x < t.Width; x++ {
+			bm.SetLevel(c.Min.X+x, c.Min.Y+y, t.Level(x, y))
+		}
+	}
+}
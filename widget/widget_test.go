@@ -0,0 +1,361 @@
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"github.com/abaschen/tinygo-epd47-s3/epd47"
+)
+
+// The driver is a Display.
+var _ Display = (*epd47.Device)(nil)
+
+// The battery monitor is a BatterySource.
+var _ BatterySource = (*epd47.BatteryMonitor)(nil)
+
+// fakeDisplay records partial updates and keeps the pixels it was sent.
+type fakeDisplay struct {
+	panel  *epd47.Bitmap4bpp
+	clears []image.Rectangle
+	draws  []image.Rectangle
+	err    error // returned by every draw when set
+}
+
+func newFakeDisplay(w, h int) *fakeDisplay {
+	return &fakeDisplay{panel: epd47.NewBitmap4bpp(w, h)}
+}
+
+func (f *fakeDisplay) Bounds() image.Rectangle { return f.panel.Bounds() }
+
+func (f *fakeDisplay) ClearArea(r image.Rectangle, cycles int) {
+	f.clears = append(f.clears, r)
+	f.panel.FillRect(r, 0)
+}
+
+func (f *fakeDisplay) DrawRows4bpp(x, y, w, h int, src epd47.RowSource, mode epd47.DrawMode) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.draws = append(f.draws, image.Rect(x, y, x+w, y+h))
+	bm := epd47.NewBitmap4bpp(w, h)
+	for j := 0; j < h; j++ {
+		if err := src.ReadRow(j, bm.Pix[j*bm.Stride:(j+1)*bm.Stride]); err != nil {
+			return err
+		}
+	}
+	for j := 0; j < h; j++ {
+		for i := 0; i < w; i++ {
+			f.panel.SetLevel(x+i, y+j, max(f.panel.Level(x+i, y+j), bm.Level(i, j)))
+		}
+	}
+	return nil
+}
+
+// inked counts non-white pixels of b inside r.
+func inked(b *epd47.Bitmap4bpp, r image.Rectangle) int {
+	n := 0
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			if b.Level(x, y) != 0 {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func TestScreenPartialUpdates(t *testing.T) {
+	fd := newFakeDisplay(200, 100)
+	s := NewScreen(fd)
+	title := NewLabel("Hello", nil)
+	title.SetBounds(image.Rect(3, 0, 80, 20))
+	bar := NewProgressBar()
+	bar.SetBounds(image.Rect(100, 50, 180, 60))
+	s.Add(title, bar)
+
+	if n, _ := s.Update(); n != 2 {
+		t.Fatalf("Expected the first update to draw both widgets, got %d", n)
+	}
+	if fd.draws[0] != image.Rect(3, 0, 80, 20) {
+		t.Errorf("Expected the label area drawn at odd x, got %v", fd.draws[0])
+	}
+	if inked(fd.panel, title.Bounds()) == 0 {
+		t.Error("Expected label text on the panel")
+	}
+	if n, _ := s.Update(); n != 0 {
+		t.Errorf("Expected nothing to repaint, got %d updates", n)
+	}
+
+	// Only the changed widget is repainted; same-value writes are free.
+	title.SetText("Hello")
+	bar.SetValue(0.5)
+	fd.draws = nil
+	if n, _ := s.Update(); n != 1 || fd.draws[0] != image.Rect(100, 50, 180, 60) {
+		t.Errorf("Expected one update of the bar, got %d: %v", n, fd.draws)
+	}
+	if len(fd.clears) != 3 {
+		t.Errorf("Expected every update to clear its area first, got %d clears", len(fd.clears))
+	}
+
+	// Moving repaints both the old and the new area; overlapping areas
+	// merge into one update.
+	bar.SetBounds(image.Rect(100, 40, 180, 52))
+	if rs := s.Dirty(); len(rs) != 1 || rs[0] != image.Rect(100, 40, 180, 60) {
+		t.Errorf("Expected one merged area, got %v", rs)
+	}
+	s.Update()
+	if inked(fd.panel, image.Rect(100, 52, 180, 60)) != 0 {
+		t.Error("Expected the old bar area cleared")
+	}
+
+	// Hidden and removed widgets leave a blank area behind.
+	title.SetHidden(true)
+	s.Update()
+	if inked(fd.panel, title.Bounds()) != 0 {
+		t.Error("Expected the hidden label erased")
+	}
+	s.Remove(bar)
+	if rs := s.Dirty(); len(rs) != 1 {
+		t.Errorf("Expected the removed bar's area to repaint, got %v", rs)
+	}
+	s.Update()
+	if inked(fd.panel, fd.panel.Bounds()) != 0 {
+		t.Error("Expected a blank panel")
+	}
+
+	s.Invalidate()
+	if rs := s.Dirty(); len(rs) != 1 || rs[0] != fd.Bounds() {
+		t.Errorf("Expected a full repaint, got %v", rs)
+	}
+}
+
+func TestScreenDeferredUpdate(t *testing.T) {
+	fd := newFakeDisplay(200, 100)
+	s := NewScreen(fd)
+	bar := NewProgressBar()
+	bar.SetBounds(image.Rect(10, 10, 90, 20))
+	bar.SetValue(1)
+	s.Add(bar)
+
+	fd.err = epd47.ErrLowPower
+	if n, err := s.Update(); n != 0 || err != epd47.ErrLowPower {
+		t.Fatalf("Expected the skipped draw reported, got %d, %v", n, err)
+	}
+	if !bar.Dirty() {
+		t.Error("Expected the bar to stay dirty after a skipped draw")
+	}
+
+	fd.err = nil
+	if n, err := s.Update(); n != 1 || err != nil {
+		t.Fatalf("Expected the bar drawn on retry, got %d, %v", n, err)
+	}
+	if bar.Dirty() || inked(fd.panel, bar.Bounds()) == 0 {
+		t.Error("Expected the bar on the panel after the retry")
+	}
+}
+
+func TestOverlappingWidgetsDrawInOrder(t *testing.T) {
+	fd := newFakeDisplay(100, 40)
+	s := NewScreen(fd)
+	back := NewBarChart()
+	back.SetBounds(image.Rect(0, 0, 40, 40))
+	back.SetValues([]float32{1})
+	back.Ink = 4
+	front := NewBattery()
+	front.SetBounds(image.Rect(20, 10, 80, 30))
+	front.SetPercent(100)
+	s.Add(back, front)
+	s.Update()
+	if len(fd.draws) != 1 {
+		t.Fatalf("Expected overlapping widgets in one update, got %v", fd.draws)
+	}
+
+	// Repainting the front widget redraws the back one beneath it.
+	front.SetPercent(0)
+	s.Update()
+	if fd.draws[1] != image.Rect(20, 10, 80, 30) {
+		t.Errorf("Unexpected repaint area %v", fd.draws[1])
+	}
+	if fd.panel.Level(25, 20) != 4 {
+		t.Errorf("Expected the bar to show through the empty battery, got %d", fd.panel.Level(25, 20))
+	}
+	if fd.panel.Level(20, 10) != 15 {
+		t.Error("Expected the battery outline on top")
+	}
+}
+
+// spill is a widget that inks everything it is given.
+type spill struct{ Base }
+
+func (s *spill) Draw(dst *epd47.Bitmap4bpp, r image.Rectangle) { dst.Fill(15) }
+
+func TestScreenClipsWidgets(t *testing.T) {
+	fd := newFakeDisplay(100, 20)
+	s := NewScreen(fd)
+	w := &spill{}
+	w.SetBounds(image.Rect(0, 0, 50, 20))
+	label := NewLabel("x", nil)
+	label.SetBounds(image.Rect(40, 0, 100, 20))
+	s.Add(w, label)
+	s.Update()
+	if len(fd.draws) != 1 || inked(fd.panel, w.Bounds()) != 50*20 {
+		t.Fatalf("Expected one update filling the widget, got %v", fd.draws)
+	}
+	if n := inked(fd.panel, image.Rect(50, 0, 100, 20)); n > 50*20/2 {
+		t.Errorf("Expected the neighbour's area kept clear, got %d inked pixels", n)
+	}
+}
+
+func TestProgressAndBattery(t *testing.T) {
+	b := epd47.NewBitmap4bpp(104, 10)
+	p := NewProgressBar()
+	p.SetValue(2)
+	if p.Value() != 1 {
+		t.Errorf("Expected the value clamped to 1, got %v", p.Value())
+	}
+	p.SetValue(0.25)
+	p.Draw(b, b.Bounds())
+	// The fill spans a quarter of the 100px inside the outline and gap.
+	if inked(b, image.Rect(2, 2, 102, 8)) != 25*6 {
+		t.Errorf("Expected 25 filled columns, got %d pixels", inked(b, image.Rect(2, 2, 102, 8)))
+	}
+
+	bat := NewBattery()
+	full := epd47.NewBitmap4bpp(60, 20)
+	bat.SetPercent(100)
+	bat.Draw(full, full.Bounds())
+	half := epd47.NewBitmap4bpp(60, 20)
+	bat.SetPercent(50)
+	bat.Draw(half, half.Bounds())
+	a, c := inked(full, full.Bounds()), inked(half, half.Bounds())
+	if c >= a || c == 0 {
+		t.Errorf("Expected a half battery to show less ink, got %d and %d", c, a)
+	}
+	bat.SetPercent(-5)
+	if bat.Percent() != 0 {
+		t.Errorf("Expected the percentage clamped to 0, got %d", bat.Percent())
+	}
+}
+
+// fakeCharge reports its value only once sampled.
+type fakeCharge struct{ now, sampled int }
+
+func (c *fakeCharge) Sample() float32 { c.sampled = c.now; return 0 }
+func (c *fakeCharge) Percent() int    { return c.sampled }
+
+func TestBatteryLevel(t *testing.T) {
+	charge := fakeCharge{now: 80}
+	fd := newFakeDisplay(200, 40)
+	s := NewScreen(fd)
+	bl := NewBatteryLevel(&charge)
+	bl.SetBounds(image.Rect(10, 10, 120, 30))
+	s.Add(bl)
+	bl.Refresh()
+	s.Update()
+	if bl.Percent() != 80 {
+		t.Errorf("Expected 80%%, got %d", bl.Percent())
+	}
+	if inked(fd.panel, image.Rect(10, 10, 50, 30)) == 0 || inked(fd.panel, image.Rect(55, 10, 120, 30)) == 0 {
+		t.Error("Expected the icon and the percentage drawn")
+	}
+
+	// An unchanged reading does not repaint.
+	bl.Refresh()
+	if bl.Dirty() {
+		t.Error("Expected no repaint for the same charge")
+	}
+	charge.now = 15
+	bl.Refresh()
+	if !bl.Dirty() || bl.Percent() != 15 {
+		t.Error("Expected a new charge to repaint")
+	}
+}
+
+func TestGauge(t *testing.T) {
+	g := NewGauge(0, 100)
+	b := epd47.NewBitmap4bpp(100, 100)
+	g.SetValue(50)
+	g.Draw(b, b.Bounds())
+	// Half way the left side of the ring is filled, the right side is track
+	// and the bottom opening is blank.
+	if b.Level(3, 50) != 15 {
+		t.Errorf("Expected the filled arc on the left, got %d", b.Level(3, 50))
+	}
+	if b.Level(96, 50) != 3 {
+		t.Errorf("Expected the track on the right, got %d", b.Level(96, 50))
+	}
+	if b.Level(50, 97) != 0 {
+		t.Errorf("Expected the opening at the bottom, got %d", b.Level(50, 97))
+	}
+	if inked(b, image.Rect(30, 40, 70, 60)) == 0 {
+		t.Error("Expected the value printed in the middle")
+	}
+}
+
+func TestSparklineAndBars(t *testing.T) {
+	s := NewSparkline(5)
+	for _, v := range []float32{9, 1, 2, 3, 4, 5} {
+		s.Push(v)
+	}
+	if s.Len() != 5 || s.At(0) != 1 || s.At(4) != 5 {
+		t.Fatalf("Expected the oldest value dropped, got %d values from %v", s.Len(), s.At(0))
+	}
+	b := epd47.NewBitmap4bpp(9, 9)
+	s.Draw(b, b.Bounds())
+	// Auto-scaled: the minimum is at the bottom left, the maximum top right.
+	if b.Level(0, 8) != 15 || b.Level(8, 0) != 15 {
+		t.Error("Expected the line to span the box corner to corner")
+	}
+
+	c := NewBarChart()
+	c.Gap = 1
+	c.SetValues([]float32{1, 2, -1})
+	c.dirty = false
+	c.SetValues([]float32{1, 2, -1})
+	if c.Dirty() {
+		t.Error("Expected equal values not to dirty the chart")
+	}
+	b = epd47.NewBitmap4bpp(8, 10)
+	c.Draw(b, b.Bounds())
+	if inked(b, image.Rect(0, 0, 2, 10)) != 2*5 || inked(b, image.Rect(3, 0, 5, 10)) != 2*10 || inked(b, image.Rect(6, 0, 8, 10)) != 0 {
+		t.Error("Unexpected bar heights")
+	}
+
+	// More bars than pixels stop at the right edge.
+	c.SetValues(make([]float32, 20))
+	for i := range 20 {
+		c.vals[i] = 1
+	}
+	b = epd47.NewBitmap4bpp(20, 10)
+	c.Draw(b, image.Rect(0, 0, 8, 10))
+	if inked(b, image.Rect(8, 0, 20, 10)) != 0 || inked(b, image.Rect(0, 0, 8, 10)) == 0 {
+		t.Error("Expected bars only inside the chart")
+	}
+}
+
+func TestScreenRotation(t *testing.T) {
+	fd := newFakeDisplay(200, 100)
+	s := NewScreen(fd)
+	s.SetRotation(epd47.Rotate90)
+	if s.Bounds() != image.Rect(0, 0, 100, 200) {
+		t.Fatalf("Expected a portrait screen, got %v", s.Bounds())
+	}
+	bar := NewProgressBar()
+	bar.Border = 0
+	bar.SetValue(1)
+	bar.SetBounds(image.Rect(0, 0, 10, 3))
+	s.Add(bar)
+	s.ClearCycles = 0
+	s.Update() // full repaint after rotating
+	fd.draws = nil
+	bar.SetValue(0)
+	bar.SetValue(1)
+	s.Update()
+	// Logical top-left lands on the panel's top-right, turned on its side.
+	if len(fd.draws) != 1 || fd.draws[0] != image.Rect(197, 0, 200, 10) {
+		t.Fatalf("Unexpected panel area %v", fd.draws)
+	}
+	if inked(fd.panel, image.Rect(197, 0, 200, 10)) != 30 || inked(fd.panel, fd.panel.Bounds()) != 30 {
+		t.Error("Expected the bar drawn vertically at the right edge")
+	}
+}